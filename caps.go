@@ -0,0 +1,133 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+import "fmt"
+import "strings"
+import "unsafe"
+
+// The type Caps describes the implementation behind the current context. It is filled in by Init.
+type Caps struct {
+	Version      string // GL_VERSION string
+	Major, Minor int    // version number parsed from Version
+	GLSLVersion  string // GL_SHADING_LANGUAGE_VERSION string
+	Vendor       string
+	Renderer     string
+	Extensions   map[string]bool // names of the supported extensions, e.g. "GL_KHR_debug"
+
+	MaxTextureSize               int
+	Max3DTextureSize             int
+	MaxCubeMapTextureSize        int
+	MaxArrayTextureLayers        int
+	MaxRenderbufferSize          int
+	MaxVertexAttribs             int
+	MaxTextureImageUnits         int // texture units accessible from the fragment shader
+	MaxCombinedTextureImageUnits int // texture units accessible from all shader stages together
+	MaxVertexUniformComponents   int
+	MaxFragmentUniformComponents int
+	MaxUniformBlockSize          int // in bytes
+	MaxUniformBufferBindings     int
+	MaxDrawBuffers               int
+	MaxColorAttachments          int
+	MaxSamples                   int
+	MaxViewportDims              [2]int
+}
+
+var caps Caps
+
+// GetCaps returns the capabilities of the context that was current when Init was last called.
+func GetCaps() *Caps {
+	return &caps
+}
+
+// AtLeast reports whether the GL version is at least major.minor.
+func (c *Caps) AtLeast(major int, minor int) bool {
+	return c.Major > major || c.Major == major && c.Minor >= minor
+}
+
+// HasExtension reports whether the named extension is supported.
+func (c *Caps) HasExtension(name string) bool {
+	return c.Extensions[name]
+}
+
+func getString(name C.GLenum) string {
+	s := C.glGetString(name)
+	if s == nil {
+		return ""
+	}
+	return C.GoString((*C.char)(unsafe.Pointer(s)))
+}
+
+// parseVersion extracts the version number from a GL_VERSION string such as "4.5 (Core Profile) Mesa 21.0" or "OpenGL ES 3.2 ...".
+func parseVersion(s string) (major, minor int) {
+	if i := strings.IndexAny(s, "0123456789"); i >= 0 {
+		fmt.Sscanf(s[i:], "%d.%d", &major, &minor)
+	}
+	return
+}
+
+func getInteger(name C.GLenum) int {
+	var v C.GLint
+	C.glGetIntegerv(name, &v)
+	return int(v)
+}
+
+type capsLimit struct {
+	name C.GLenum
+	v    *int
+}
+
+// queryCaps fills in caps from the current context.
+func queryCaps() {
+	c := Caps{
+		Version:     getString(VERSION),
+		GLSLVersion: getString(SHADING_LANGUAGE_VERSION),
+		Vendor:      getString(VENDOR),
+		Renderer:    getString(RENDERER),
+		Extensions:  make(map[string]bool),
+	}
+	c.Major, c.Minor = parseVersion(c.Version)
+	if c.AtLeast(3, 0) {
+		n := getInteger(NUM_EXTENSIONS)
+		for i := 0; i < n; i++ {
+			s := C.glGetStringi(EXTENSIONS, C.GLuint(i))
+			c.Extensions[C.GoString((*C.char)(unsafe.Pointer(s)))] = true
+		}
+	} else {
+		for _, s := range strings.Fields(getString(EXTENSIONS)) {
+			c.Extensions[s] = true
+		}
+	}
+	limits := []capsLimit{
+		{MAX_TEXTURE_SIZE, &c.MaxTextureSize},
+		{MAX_3D_TEXTURE_SIZE, &c.Max3DTextureSize},
+		{MAX_CUBE_MAP_TEXTURE_SIZE, &c.MaxCubeMapTextureSize},
+		{MAX_RENDERBUFFER_SIZE, &c.MaxRenderbufferSize},
+		{MAX_VERTEX_ATTRIBS, &c.MaxVertexAttribs},
+		{MAX_TEXTURE_IMAGE_UNITS, &c.MaxTextureImageUnits},
+		{MAX_COMBINED_TEXTURE_IMAGE_UNITS, &c.MaxCombinedTextureImageUnits},
+		{MAX_VERTEX_UNIFORM_COMPONENTS, &c.MaxVertexUniformComponents},
+		{MAX_FRAGMENT_UNIFORM_COMPONENTS, &c.MaxFragmentUniformComponents},
+		{MAX_DRAW_BUFFERS, &c.MaxDrawBuffers},
+	}
+	if c.AtLeast(3, 0) {
+		limits = append(limits, []capsLimit{
+			{MAX_ARRAY_TEXTURE_LAYERS, &c.MaxArrayTextureLayers},
+			{MAX_COLOR_ATTACHMENTS, &c.MaxColorAttachments},
+			{MAX_SAMPLES, &c.MaxSamples},
+		}...)
+	}
+	if c.AtLeast(3, 1) || c.HasExtension("GL_ARB_uniform_buffer_object") {
+		limits = append(limits, []capsLimit{
+			{MAX_UNIFORM_BLOCK_SIZE, &c.MaxUniformBlockSize},
+			{MAX_UNIFORM_BUFFER_BINDINGS, &c.MaxUniformBufferBindings},
+		}...)
+	}
+	for _, l := range limits {
+		*l.v = getInteger(l.name)
+	}
+	var dims [2]C.GLint
+	C.glGetIntegerv(MAX_VIEWPORT_DIMS, &dims[0])
+	c.MaxViewportDims = [2]int{int(dims[0]), int(dims[1])}
+	caps = c
+}
@@ -0,0 +1,245 @@
+package gl
+
+// #include <stdlib.h>
+// #include "glfuncs.h"
+// void goDebugCallback(GLenum source, GLenum type, GLuint id, GLenum severity, GLsizei length, GLchar *message, void *user);
+import "C"
+import "fmt"
+import "unsafe"
+
+// The type DebugSource identifies the origin of a debug message.
+type DebugSource int
+
+const (
+	DebugSourceAPI            = DebugSource(DEBUG_SOURCE_API)
+	DebugSourceWindowSystem   = DebugSource(DEBUG_SOURCE_WINDOW_SYSTEM)
+	DebugSourceShaderCompiler = DebugSource(DEBUG_SOURCE_SHADER_COMPILER)
+	DebugSourceThirdParty     = DebugSource(DEBUG_SOURCE_THIRD_PARTY)
+	DebugSourceApplication    = DebugSource(DEBUG_SOURCE_APPLICATION)
+	DebugSourceOther          = DebugSource(DEBUG_SOURCE_OTHER)
+)
+
+var debugSourceNames = map[DebugSource]string{
+	DebugSourceAPI:            "api",
+	DebugSourceWindowSystem:   "window system",
+	DebugSourceShaderCompiler: "shader compiler",
+	DebugSourceThirdParty:     "third party",
+	DebugSourceApplication:    "application",
+	DebugSourceOther:          "other",
+}
+
+func (s DebugSource) String() string {
+	if n, ok := debugSourceNames[s]; ok {
+		return n
+	}
+	return fmt.Sprintf("DebugSource(%#x)", int(s))
+}
+
+// The type DebugType classifies a debug message.
+type DebugType int
+
+const (
+	DebugTypeError              = DebugType(DEBUG_TYPE_ERROR)
+	DebugTypeDeprecatedBehavior = DebugType(DEBUG_TYPE_DEPRECATED_BEHAVIOR)
+	DebugTypeUndefinedBehavior  = DebugType(DEBUG_TYPE_UNDEFINED_BEHAVIOR)
+	DebugTypePortability        = DebugType(DEBUG_TYPE_PORTABILITY)
+	DebugTypePerformance        = DebugType(DEBUG_TYPE_PERFORMANCE)
+	DebugTypeMarker             = DebugType(DEBUG_TYPE_MARKER)
+	DebugTypePushGroup          = DebugType(DEBUG_TYPE_PUSH_GROUP)
+	DebugTypePopGroup           = DebugType(DEBUG_TYPE_POP_GROUP)
+	DebugTypeOther              = DebugType(DEBUG_TYPE_OTHER)
+)
+
+var debugTypeNames = map[DebugType]string{
+	DebugTypeError:              "error",
+	DebugTypeDeprecatedBehavior: "deprecated behavior",
+	DebugTypeUndefinedBehavior:  "undefined behavior",
+	DebugTypePortability:        "portability",
+	DebugTypePerformance:        "performance",
+	DebugTypeMarker:             "marker",
+	DebugTypePushGroup:          "push group",
+	DebugTypePopGroup:           "pop group",
+	DebugTypeOther:              "other",
+}
+
+func (t DebugType) String() string {
+	if n, ok := debugTypeNames[t]; ok {
+		return n
+	}
+	return fmt.Sprintf("DebugType(%#x)", int(t))
+}
+
+// The type DebugSeverity gives the importance of a debug message.
+type DebugSeverity int
+
+const (
+	DebugSeverityNotification = DebugSeverity(DEBUG_SEVERITY_NOTIFICATION)
+	DebugSeverityLow          = DebugSeverity(DEBUG_SEVERITY_LOW)
+	DebugSeverityMedium       = DebugSeverity(DEBUG_SEVERITY_MEDIUM)
+	DebugSeverityHigh         = DebugSeverity(DEBUG_SEVERITY_HIGH)
+)
+
+// debugSeverities lists the severities in increasing order of importance.
+var debugSeverities = []DebugSeverity{DebugSeverityNotification, DebugSeverityLow, DebugSeverityMedium, DebugSeverityHigh}
+
+var debugSeverityNames = map[DebugSeverity]string{
+	DebugSeverityNotification: "notification",
+	DebugSeverityLow:          "low",
+	DebugSeverityMedium:       "medium",
+	DebugSeverityHigh:         "high",
+}
+
+func (s DebugSeverity) String() string {
+	if n, ok := debugSeverityNames[s]; ok {
+		return n
+	}
+	return fmt.Sprintf("DebugSeverity(%#x)", int(s))
+}
+
+// The type DebugMessage represents a message delivered by the debug output of the GL.
+type DebugMessage struct {
+	Source   DebugSource
+	Type     DebugType
+	ID       int
+	Severity DebugSeverity
+	Message  string
+}
+
+func (m DebugMessage) String() string {
+	return fmt.Sprintf("gl: %s %s %d (%s): %s", m.Source, m.Type, m.ID, m.Severity, m.Message)
+}
+
+var debugCallback func(DebugMessage)
+
+//export goDebugCallback
+func goDebugCallback(source C.GLenum, typ C.GLenum, id C.GLuint, severity C.GLenum, length C.GLsizei, message *C.GLchar, user unsafe.Pointer) {
+	if debugCallback == nil {
+		return
+	}
+	debugCallback(DebugMessage{
+		Source:   DebugSource(source),
+		Type:     DebugType(typ),
+		ID:       int(id),
+		Severity: DebugSeverity(severity),
+		Message:  C.GoStringN((*C.char)(message), C.int(length)),
+	})
+}
+
+// debugARB reports whether the debug output has to be used through ARB_debug_output, since the context provides neither OpenGL 4.3 nor KHR_debug.
+func debugARB() bool {
+	return !caps.AtLeast(4, 3) && !caps.HasExtension("GL_KHR_debug") && caps.HasExtension("GL_ARB_debug_output")
+}
+
+// SetDebugCallback installs f using glDebugMessageCallback, so that it receives the messages of the debug output, and enables DEBUG_OUTPUT. A nil f removes the callback and disables DEBUG_OUTPUT again.
+// DEBUG_OUTPUT_SYNCHRONOUS is enabled as well, so f is called on the thread of the GL call producing the message, before the call returns.
+// This requires OpenGL 4.3, KHR_debug or ARB_debug_output, in which case glDebugMessageCallbackARB is used; many implementations only produce messages for contexts created with the debug flag.
+func SetDebugCallback(f func(DebugMessage)) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("SetDebugCallback", f))
+	}
+	debugCallback = f
+	if debugARB() {
+		// the output of ARB_debug_output cannot be switched off, only its callback
+		if f == nil {
+			C.glDebugMessageCallbackARB(nil, nil)
+			return
+		}
+		C.glEnable(DEBUG_OUTPUT_SYNCHRONOUS_ARB)
+		C.glDebugMessageCallbackARB(C.GLDEBUGPROCARB(C.goDebugCallback), nil)
+		return
+	}
+	if f == nil {
+		C.glDebugMessageCallback(nil, nil)
+		C.glDisable(C.GLenum(DEBUG_OUTPUT))
+		return
+	}
+	C.glEnable(C.GLenum(DEBUG_OUTPUT))
+	C.glEnable(C.GLenum(DEBUG_OUTPUT_SYNCHRONOUS))
+	C.glDebugMessageCallback(C.GLDEBUGPROC(C.goDebugCallback), nil)
+}
+
+// SetDebugSeverity calls glDebugMessageControl, or glDebugMessageControlARB, to enable messages of severity min and above and disable all less important ones.
+// ARB_debug_output has no notifications, so they are left alone there.
+func SetDebugSeverity(min DebugSeverity) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("SetDebugSeverity", min))
+	}
+	arb := debugARB()
+	enabled := C.GLboolean(FALSE)
+	for _, s := range debugSeverities {
+		if s == min {
+			enabled = TRUE
+		}
+		switch {
+		case !arb:
+			C.glDebugMessageControl(DONT_CARE, DONT_CARE, C.GLenum(s), 0, nil, enabled)
+		case s != DebugSeverityNotification:
+			C.glDebugMessageControlARB(DONT_CARE, DONT_CARE, C.GLenum(s), 0, nil, enabled)
+		}
+	}
+}
+
+// InsertDebugMessage calls glDebugMessageInsert, or glDebugMessageInsertARB, to inject a message with source DebugSourceApplication into the debug output.
+// ARB_debug_output does not know the notification severity and the marker and group types.
+func InsertDebugMessage(typ DebugType, id int, severity DebugSeverity, message string) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("InsertDebugMessage", typ, id, severity, message))
+	}
+	s := C.CString(message)
+	defer C.free(unsafe.Pointer(s))
+	if debugARB() {
+		C.glDebugMessageInsertARB(DEBUG_SOURCE_APPLICATION, C.GLenum(typ), C.GLuint(id), C.GLenum(severity), -1, (*C.GLchar)(s))
+		return
+	}
+	C.glDebugMessageInsert(DEBUG_SOURCE_APPLICATION, C.GLenum(typ), C.GLuint(id), C.GLenum(severity), -1, (*C.GLchar)(s))
+}
+
+// PushDebugGroup calls glPushDebugGroup to open a named group of commands, which debuggers use to structure their output. Groups are closed with PopDebugGroup.
+// Debug groups and the object labels set by SetLabel require OpenGL 4.3 or KHR_debug; ARB_debug_output does not provide them.
+func PushDebugGroup(id int, message string) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("PushDebugGroup", id, message))
+	}
+	s := C.CString(message)
+	defer C.free(unsafe.Pointer(s))
+	C.glPushDebugGroup(DEBUG_SOURCE_APPLICATION, C.GLuint(id), -1, (*C.GLchar)(s))
+}
+
+// PopDebugGroup calls glPopDebugGroup
+func PopDebugGroup() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("PopDebugGroup"))
+	}
+	C.glPopDebugGroup()
+}
+
+// objectLabel calls glObjectLabel to attach a label to the object name of the given type.
+func objectLabel(typ C.GLenum, name C.GLuint, label string) {
+	s := C.CString(label)
+	defer C.free(unsafe.Pointer(s))
+	C.glObjectLabel(typ, name, -1, (*C.GLchar)(s))
+}
+
+// SetLabel calls glObjectLabel to give the buffer a name that appears in debug messages and debuggers.
+func (buf *Buffer) SetLabel(label string) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.SetLabel", label))
+	}
+	objectLabel(BUFFER, buf.i, label)
+}
+
+// SetLabel calls glObjectLabel to give the texture a name that appears in debug messages and debuggers.
+func (t Texture) SetLabel(label string) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.SetLabel", label))
+	}
+	objectLabel(TEXTURE, C.GLuint(t), label)
+}
+
+// SetLabel calls glObjectLabel to give the program a name that appears in debug messages and debuggers.
+func (p *Program) SetLabel(label string) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.SetLabel", label))
+	}
+	objectLabel(PROGRAM, p.i, label)
+}
@@ -0,0 +1,326 @@
+// Code generated by glgen from gl.xml; DO NOT EDIT.
+
+package gl
+
+import "fmt"
+import "strings"
+
+// The type Capability is a server-side capability switched with Enable and Disable.
+type Capability int
+
+var capabilityNames = map[Capability]string{
+	LINE_SMOOTH:                   "LINE_SMOOTH",
+	POLYGON_SMOOTH:                "POLYGON_SMOOTH",
+	CULL_FACE:                     "CULL_FACE",
+	DEPTH_TEST:                    "DEPTH_TEST",
+	STENCIL_TEST:                  "STENCIL_TEST",
+	DITHER:                        "DITHER",
+	BLEND:                         "BLEND",
+	SCISSOR_TEST:                  "SCISSOR_TEST",
+	COLOR_LOGIC_OP:                "COLOR_LOGIC_OP",
+	POLYGON_OFFSET_POINT:          "POLYGON_OFFSET_POINT",
+	POLYGON_OFFSET_LINE:           "POLYGON_OFFSET_LINE",
+	POLYGON_OFFSET_FILL:           "POLYGON_OFFSET_FILL",
+	MULTISAMPLE:                   "MULTISAMPLE",
+	SAMPLE_ALPHA_TO_COVERAGE:      "SAMPLE_ALPHA_TO_COVERAGE",
+	SAMPLE_ALPHA_TO_ONE:           "SAMPLE_ALPHA_TO_ONE",
+	SAMPLE_COVERAGE:               "SAMPLE_COVERAGE",
+	RASTERIZER_DISCARD:            "RASTERIZER_DISCARD",
+	FRAMEBUFFER_SRGB:              "FRAMEBUFFER_SRGB",
+	PRIMITIVE_RESTART:             "PRIMITIVE_RESTART",
+	PROGRAM_POINT_SIZE:            "PROGRAM_POINT_SIZE",
+	DEPTH_CLAMP:                   "DEPTH_CLAMP",
+	TEXTURE_CUBE_MAP_SEAMLESS:     "TEXTURE_CUBE_MAP_SEAMLESS",
+	SAMPLE_MASK:                   "SAMPLE_MASK",
+	SAMPLE_SHADING:                "SAMPLE_SHADING",
+	PRIMITIVE_RESTART_FIXED_INDEX: "PRIMITIVE_RESTART_FIXED_INDEX",
+	DEBUG_OUTPUT_SYNCHRONOUS:      "DEBUG_OUTPUT_SYNCHRONOUS",
+	DEBUG_OUTPUT:                  "DEBUG_OUTPUT",
+}
+
+func (c Capability) String() string {
+	if n, ok := capabilityNames[c]; ok {
+		return n
+	}
+	return fmt.Sprintf("Capability(%#x)", int(c))
+}
+
+// The type BlendFactor is a source or destination factor for BlendFunc.
+type BlendFactor int
+
+var blendFactorNames = map[BlendFactor]string{
+	ZERO:                     "ZERO",
+	ONE:                      "ONE",
+	SRC_COLOR:                "SRC_COLOR",
+	ONE_MINUS_SRC_COLOR:      "ONE_MINUS_SRC_COLOR",
+	SRC_ALPHA:                "SRC_ALPHA",
+	ONE_MINUS_SRC_ALPHA:      "ONE_MINUS_SRC_ALPHA",
+	DST_ALPHA:                "DST_ALPHA",
+	ONE_MINUS_DST_ALPHA:      "ONE_MINUS_DST_ALPHA",
+	DST_COLOR:                "DST_COLOR",
+	ONE_MINUS_DST_COLOR:      "ONE_MINUS_DST_COLOR",
+	SRC_ALPHA_SATURATE:       "SRC_ALPHA_SATURATE",
+	CONSTANT_COLOR:           "CONSTANT_COLOR",
+	ONE_MINUS_CONSTANT_COLOR: "ONE_MINUS_CONSTANT_COLOR",
+	CONSTANT_ALPHA:           "CONSTANT_ALPHA",
+	ONE_MINUS_CONSTANT_ALPHA: "ONE_MINUS_CONSTANT_ALPHA",
+}
+
+func (b BlendFactor) String() string {
+	if n, ok := blendFactorNames[b]; ok {
+		return n
+	}
+	return fmt.Sprintf("BlendFactor(%#x)", int(b))
+}
+
+// The type BufferTarget is a binding point for buffer objects.
+type BufferTarget int
+
+var bufferTargetNames = map[BufferTarget]string{
+	ARRAY_BUFFER:              "ARRAY_BUFFER",
+	ELEMENT_ARRAY_BUFFER:      "ELEMENT_ARRAY_BUFFER",
+	PIXEL_PACK_BUFFER:         "PIXEL_PACK_BUFFER",
+	PIXEL_UNPACK_BUFFER:       "PIXEL_UNPACK_BUFFER",
+	TRANSFORM_FEEDBACK_BUFFER: "TRANSFORM_FEEDBACK_BUFFER",
+	TEXTURE_BUFFER:            "TEXTURE_BUFFER",
+	COPY_READ_BUFFER:          "COPY_READ_BUFFER",
+	COPY_WRITE_BUFFER:         "COPY_WRITE_BUFFER",
+	UNIFORM_BUFFER:            "UNIFORM_BUFFER",
+	DRAW_INDIRECT_BUFFER:      "DRAW_INDIRECT_BUFFER",
+	ATOMIC_COUNTER_BUFFER:     "ATOMIC_COUNTER_BUFFER",
+	DISPATCH_INDIRECT_BUFFER:  "DISPATCH_INDIRECT_BUFFER",
+	SHADER_STORAGE_BUFFER:     "SHADER_STORAGE_BUFFER",
+}
+
+func (b BufferTarget) String() string {
+	if n, ok := bufferTargetNames[b]; ok {
+		return n
+	}
+	return fmt.Sprintf("BufferTarget(%#x)", int(b))
+}
+
+// The type BufferUsage is a hint about how the contents of a buffer are accessed.
+type BufferUsage int
+
+var bufferUsageNames = map[BufferUsage]string{
+	STREAM_DRAW:  "STREAM_DRAW",
+	STREAM_READ:  "STREAM_READ",
+	STREAM_COPY:  "STREAM_COPY",
+	STATIC_DRAW:  "STATIC_DRAW",
+	STATIC_READ:  "STATIC_READ",
+	STATIC_COPY:  "STATIC_COPY",
+	DYNAMIC_DRAW: "DYNAMIC_DRAW",
+	DYNAMIC_READ: "DYNAMIC_READ",
+	DYNAMIC_COPY: "DYNAMIC_COPY",
+}
+
+func (b BufferUsage) String() string {
+	if n, ok := bufferUsageNames[b]; ok {
+		return n
+	}
+	return fmt.Sprintf("BufferUsage(%#x)", int(b))
+}
+
+// The type PrimitiveMode is the kind of primitives assembled by the draw calls.
+type PrimitiveMode int
+
+var primitiveModeNames = map[PrimitiveMode]string{
+	POINTS:                   "POINTS",
+	LINES:                    "LINES",
+	LINE_LOOP:                "LINE_LOOP",
+	LINE_STRIP:               "LINE_STRIP",
+	TRIANGLES:                "TRIANGLES",
+	TRIANGLE_STRIP:           "TRIANGLE_STRIP",
+	TRIANGLE_FAN:             "TRIANGLE_FAN",
+	LINES_ADJACENCY:          "LINES_ADJACENCY",
+	LINE_STRIP_ADJACENCY:     "LINE_STRIP_ADJACENCY",
+	TRIANGLES_ADJACENCY:      "TRIANGLES_ADJACENCY",
+	TRIANGLE_STRIP_ADJACENCY: "TRIANGLE_STRIP_ADJACENCY",
+	PATCHES:                  "PATCHES",
+}
+
+func (p PrimitiveMode) String() string {
+	if n, ok := primitiveModeNames[p]; ok {
+		return n
+	}
+	return fmt.Sprintf("PrimitiveMode(%#x)", int(p))
+}
+
+// The type TextureTarget is a binding point for textures or, for the cube map faces, an image of a cube map texture.
+type TextureTarget int
+
+var textureTargetNames = map[TextureTarget]string{
+	TEXTURE_1D:                   "TEXTURE_1D",
+	TEXTURE_2D:                   "TEXTURE_2D",
+	TEXTURE_3D:                   "TEXTURE_3D",
+	TEXTURE_CUBE_MAP:             "TEXTURE_CUBE_MAP",
+	TEXTURE_CUBE_MAP_POSITIVE_X:  "TEXTURE_CUBE_MAP_POSITIVE_X",
+	TEXTURE_CUBE_MAP_NEGATIVE_X:  "TEXTURE_CUBE_MAP_NEGATIVE_X",
+	TEXTURE_CUBE_MAP_POSITIVE_Y:  "TEXTURE_CUBE_MAP_POSITIVE_Y",
+	TEXTURE_CUBE_MAP_NEGATIVE_Y:  "TEXTURE_CUBE_MAP_NEGATIVE_Y",
+	TEXTURE_CUBE_MAP_POSITIVE_Z:  "TEXTURE_CUBE_MAP_POSITIVE_Z",
+	TEXTURE_CUBE_MAP_NEGATIVE_Z:  "TEXTURE_CUBE_MAP_NEGATIVE_Z",
+	TEXTURE_1D_ARRAY:             "TEXTURE_1D_ARRAY",
+	TEXTURE_2D_ARRAY:             "TEXTURE_2D_ARRAY",
+	TEXTURE_BUFFER:               "TEXTURE_BUFFER",
+	TEXTURE_RECTANGLE:            "TEXTURE_RECTANGLE",
+	TEXTURE_2D_MULTISAMPLE:       "TEXTURE_2D_MULTISAMPLE",
+	TEXTURE_2D_MULTISAMPLE_ARRAY: "TEXTURE_2D_MULTISAMPLE_ARRAY",
+	TEXTURE_CUBE_MAP_ARRAY:       "TEXTURE_CUBE_MAP_ARRAY",
+}
+
+func (t TextureTarget) String() string {
+	if n, ok := textureTargetNames[t]; ok {
+		return n
+	}
+	return fmt.Sprintf("TextureTarget(%#x)", int(t))
+}
+
+// The type FramebufferTarget is a binding point for framebuffer objects.
+type FramebufferTarget int
+
+var framebufferTargetNames = map[FramebufferTarget]string{
+	READ_FRAMEBUFFER: "READ_FRAMEBUFFER",
+	DRAW_FRAMEBUFFER: "DRAW_FRAMEBUFFER",
+	FRAMEBUFFER:      "FRAMEBUFFER",
+}
+
+func (f FramebufferTarget) String() string {
+	if n, ok := framebufferTargetNames[f]; ok {
+		return n
+	}
+	return fmt.Sprintf("FramebufferTarget(%#x)", int(f))
+}
+
+// The type ShaderType is the stage of a shader object.
+type ShaderType int
+
+var shaderTypeNames = map[ShaderType]string{
+	FRAGMENT_SHADER:        "FRAGMENT_SHADER",
+	VERTEX_SHADER:          "VERTEX_SHADER",
+	GEOMETRY_SHADER:        "GEOMETRY_SHADER",
+	TESS_EVALUATION_SHADER: "TESS_EVALUATION_SHADER",
+	TESS_CONTROL_SHADER:    "TESS_CONTROL_SHADER",
+	COMPUTE_SHADER:         "COMPUTE_SHADER",
+}
+
+func (s ShaderType) String() string {
+	if n, ok := shaderTypeNames[s]; ok {
+		return n
+	}
+	return fmt.Sprintf("ShaderType(%#x)", int(s))
+}
+
+// The type CompareFunc is a comparison function, e.g. for the depth test.
+type CompareFunc int
+
+var compareFuncNames = map[CompareFunc]string{
+	NEVER:    "NEVER",
+	LESS:     "LESS",
+	EQUAL:    "EQUAL",
+	LEQUAL:   "LEQUAL",
+	GREATER:  "GREATER",
+	NOTEQUAL: "NOTEQUAL",
+	GEQUAL:   "GEQUAL",
+	ALWAYS:   "ALWAYS",
+}
+
+func (c CompareFunc) String() string {
+	if n, ok := compareFuncNames[c]; ok {
+		return n
+	}
+	return fmt.Sprintf("CompareFunc(%#x)", int(c))
+}
+
+// The type ClearMask is a combination of the buffers cleared by Clear.
+type ClearMask int
+
+var clearMaskNames = map[ClearMask]string{
+	DEPTH_BUFFER_BIT:   "DEPTH_BUFFER_BIT",
+	STENCIL_BUFFER_BIT: "STENCIL_BUFFER_BIT",
+	COLOR_BUFFER_BIT:   "COLOR_BUFFER_BIT",
+}
+
+func (c ClearMask) String() string {
+	var s []string
+	for _, b := range []ClearMask{DEPTH_BUFFER_BIT, STENCIL_BUFFER_BIT, COLOR_BUFFER_BIT} {
+		if c&b != 0 {
+			s = append(s, clearMaskNames[b])
+			c &^= b
+		}
+	}
+	if c != 0 || len(s) == 0 {
+		s = append(s, fmt.Sprintf("%#x", int(c)))
+	}
+	return strings.Join(s, "|")
+}
+
+// The type StencilAction is an action applied to the stencil buffer by StencilOp.
+type StencilAction int
+
+var stencilActionNames = map[StencilAction]string{
+	ZERO:      "ZERO",
+	INVERT:    "INVERT",
+	KEEP:      "KEEP",
+	REPLACE:   "REPLACE",
+	INCR:      "INCR",
+	DECR:      "DECR",
+	INCR_WRAP: "INCR_WRAP",
+	DECR_WRAP: "DECR_WRAP",
+}
+
+func (s StencilAction) String() string {
+	if n, ok := stencilActionNames[s]; ok {
+		return n
+	}
+	return fmt.Sprintf("StencilAction(%#x)", int(s))
+}
+
+// The type BlendOp is an equation combining the source and destination colors, see BlendEquation.
+type BlendOp int
+
+var blendOpNames = map[BlendOp]string{
+	FUNC_ADD:              "FUNC_ADD",
+	FUNC_REVERSE_SUBTRACT: "FUNC_REVERSE_SUBTRACT",
+	FUNC_SUBTRACT:         "FUNC_SUBTRACT",
+	MIN:                   "MIN",
+	MAX:                   "MAX",
+}
+
+func (b BlendOp) String() string {
+	if n, ok := blendOpNames[b]; ok {
+		return n
+	}
+	return fmt.Sprintf("BlendOp(%#x)", int(b))
+}
+
+// The type Winding is the orientation of front-facing polygons, see FrontFace.
+type Winding int
+
+var windingNames = map[Winding]string{
+	CW:  "CW",
+	CCW: "CCW",
+}
+
+func (w Winding) String() string {
+	if n, ok := windingNames[w]; ok {
+		return n
+	}
+	return fmt.Sprintf("Winding(%#x)", int(w))
+}
+
+// The type Face is a selection of polygon faces, e.g. for CullFace.
+type Face int
+
+var faceNames = map[Face]string{
+	FRONT:          "FRONT",
+	BACK:           "BACK",
+	FRONT_AND_BACK: "FRONT_AND_BACK",
+}
+
+func (f Face) String() string {
+	if n, ok := faceNames[f]; ok {
+		return n
+	}
+	return fmt.Sprintf("Face(%#x)", int(f))
+}
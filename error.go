@@ -0,0 +1,155 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+import "fmt"
+import "image"
+import "log"
+import "reflect"
+import "strings"
+
+// The type Error represents an error code returned by glGetError.
+type Error int
+
+const (
+	ErrInvalidEnum                 = Error(INVALID_ENUM)
+	ErrInvalidValue                = Error(INVALID_VALUE)
+	ErrInvalidOperation            = Error(INVALID_OPERATION)
+	ErrStackOverflow               = Error(STACK_OVERFLOW)
+	ErrStackUnderflow              = Error(STACK_UNDERFLOW)
+	ErrOutOfMemory                 = Error(OUT_OF_MEMORY)
+	ErrInvalidFramebufferOperation = Error(INVALID_FRAMEBUFFER_OPERATION)
+)
+
+var errorNames = map[Error]string{
+	ErrInvalidEnum:                 "invalid enum",
+	ErrInvalidValue:                "invalid value",
+	ErrInvalidOperation:            "invalid operation",
+	ErrStackOverflow:               "stack overflow",
+	ErrStackUnderflow:              "stack underflow",
+	ErrOutOfMemory:                 "out of memory",
+	ErrInvalidFramebufferOperation: "invalid framebuffer operation",
+}
+
+func (e Error) Error() string {
+	if s, ok := errorNames[e]; ok {
+		return s
+	}
+	return fmt.Sprintf("unknown error %#x", int(e))
+}
+
+// GetError calls glGetError and returns the error flag that was set, or nil if there is none.
+// Since several flags may be set, it should be called until it returns nil to clear all of them.
+// A call of a function the context does not provide is reported first, as an *UnsupportedError.
+func GetError() error {
+	if f := takeUnsupported(); f != "" {
+		return &UnsupportedError{f}
+	}
+	e := C.glGetError()
+	// without a context glGetError itself is a stub, whose report would never stop the loops clearing the flags
+	takeUnsupported()
+	if e == NO_ERROR {
+		return nil
+	}
+	return Error(e)
+}
+
+// The type DebugMode selects what happens when a wrapper detects an error in debug mode.
+type DebugMode int
+
+const (
+	DebugOff   DebugMode = iota // errors are not checked
+	DebugLog                    // errors are logged using the log package
+	DebugPanic                  // the wrapper panics with a *CallError
+)
+
+var debugMode DebugMode
+
+// SetDebugMode selects the debug mode. If it is not DebugOff, every wrapper calls glGetError after calling the GL and reports the errors together with its name and arguments.
+// Wrappers called from a goroutine other than the one that called Init report ErrWrongThread.
+// The default is DebugOff, in which case the checks cost nothing but a comparison.
+func SetDebugMode(m DebugMode) {
+	debugMode = m
+}
+
+// The type CallError describes an error detected by a wrapper in debug mode.
+type CallError struct {
+	Func string        // name of the wrapper, e.g. "Buffer.Set"
+	Args []interface{} // arguments passed to the wrapper
+	Err  error         // the error
+}
+
+func (e *CallError) Error() string {
+	args := make([]string, len(e.Args))
+	for i, a := range e.Args {
+		args[i] = formatArg(a)
+	}
+	return "gl: " + e.Func + "(" + strings.Join(args, ", ") + "): " + e.Err.Error()
+}
+
+// formatArg formats an argument for a CallError, abbreviating slices, long strings and images.
+func formatArg(a interface{}) string {
+	switch a := a.(type) {
+	case string:
+		if len(a) > 32 {
+			return fmt.Sprintf("%q...", a[:32])
+		}
+		return fmt.Sprintf("%q", a)
+	case image.Image:
+		return fmt.Sprintf("%T%v", a, a.Bounds())
+	}
+	v := reflect.ValueOf(a)
+	if v.Kind() == reflect.Slice {
+		return fmt.Sprintf("%s(len %d)", v.Type(), v.Len())
+	}
+	return fmt.Sprintf("%v", a)
+}
+
+// debugCall is a wrapper call in debug mode, passed from debugEnter to checkError.
+type debugCall struct {
+	fn      string
+	args    []interface{}
+	counted bool // made by the owner goroutine and counted in debugDepth
+}
+
+// debugDepth counts the nested wrapper calls in debug mode, so that errors are reported by the outermost wrapper.
+// It belongs to the goroutine that called Init; calls from other goroutines are reported by checkThread and leave it alone.
+var debugDepth int
+
+func debugEnter(fn string, args ...interface{}) debugCall {
+	c := debugCall{fn: fn, args: args}
+	if checkThread(c) {
+		debugDepth++
+		c.counted = true
+	}
+	return c
+}
+
+// checkError reports errors raised by the wrapper call c in debug mode. It is deferred by the wrappers as
+//
+//	if debugMode != DebugOff {
+//		defer checkError(debugEnter("Name", args...))
+//	}
+func checkError(c debugCall) {
+	if !c.counted {
+		return
+	}
+	debugDepth--
+	if debugDepth > 0 {
+		return
+	}
+	err := GetError()
+	if err == nil {
+		return
+	}
+	// clear any further flags
+	for GetError() != nil {
+	}
+	e := &CallError{c.fn, c.args, err}
+	switch debugMode {
+	case DebugLog:
+		log.Print(e)
+	case DebugPanic:
+		panic(e)
+	}
+}
@@ -0,0 +1,185 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+import "errors"
+
+// The type Framebuffer represents a framebuffer object.
+// Methods that need it bound, such as AttachTexture and Check, bind it temporarily and restore the previous binding afterwards.
+type Framebuffer C.GLuint
+
+// The type Renderbuffer represents a renderbuffer object.
+type Renderbuffer C.GLuint
+
+var framebufferStatus = map[C.GLenum]error{
+	FRAMEBUFFER_UNDEFINED:                     errors.New("framebuffer incomplete: default framebuffer does not exist"),
+	FRAMEBUFFER_INCOMPLETE_ATTACHMENT:         errors.New("framebuffer incomplete: an attachment is not complete"),
+	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT: errors.New("framebuffer incomplete: no image is attached"),
+	FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER:        errors.New("framebuffer incomplete: a draw buffer names a missing attachment"),
+	FRAMEBUFFER_INCOMPLETE_READ_BUFFER:        errors.New("framebuffer incomplete: the read buffer names a missing attachment"),
+	FRAMEBUFFER_UNSUPPORTED:                   errors.New("framebuffer unsupported: the combination of internal formats is not supported by the implementation"),
+	FRAMEBUFFER_INCOMPLETE_MULTISAMPLE:        errors.New("framebuffer incomplete: attachments have differing numbers of samples"),
+	FRAMEBUFFER_INCOMPLETE_LAYER_TARGETS:      errors.New("framebuffer incomplete: layered and non-layered attachments are mixed"),
+}
+
+// NewFramebuffer creates a new framebuffer object using glGenFramebuffers.
+func NewFramebuffer() Framebuffer {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewFramebuffer"))
+	}
+	var f C.GLuint
+
+	C.glGenFramebuffers(1, &f)
+	return Framebuffer(f)
+}
+
+// Delete calls glDeleteFramebuffers
+func (f Framebuffer) Delete() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Framebuffer.Delete"))
+	}
+	i := C.GLuint(f)
+	C.glDeleteFramebuffers(1, &i)
+	forgetFramebuffer(i)
+}
+
+// Bind calls glBindFramebuffer unless the framebuffer is already bound to targ. targ should be FRAMEBUFFER, DRAW_FRAMEBUFFER or READ_FRAMEBUFFER.
+func (f Framebuffer) Bind(targ FramebufferTarget) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Framebuffer.Bind", targ))
+	}
+	bindFramebuffer(targ, C.GLuint(f))
+}
+
+// Unbind calls glBindFramebuffer with a 0 argument, i.e. it binds the default framebuffer, unless it is already bound to targ.
+func (Framebuffer) Unbind(targ FramebufferTarget) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Framebuffer.Unbind", targ))
+	}
+	bindFramebuffer(targ, 0)
+}
+
+// bindTemp binds f to targ, DRAW_FRAMEBUFFER or READ_FRAMEBUFFER, and returns the framebuffer bound there before, which is restored with bindFramebuffer.
+func (f Framebuffer) bindTemp(targ FramebufferTarget) C.GLuint {
+	prev := boundFramebuffer(targ)
+	bindFramebuffer(targ, C.GLuint(f))
+	return prev
+}
+
+// AttachTexture calls glFramebufferTexture2D to attach a level of a texture. attach is COLOR_ATTACHMENT0+i, DEPTH_ATTACHMENT, STENCIL_ATTACHMENT or DEPTH_STENCIL_ATTACHMENT.
+// textarg is TEXTURE_2D or one of the cube map faces.
+func (f Framebuffer) AttachTexture(attach int, textarg TextureTarget, t Texture, level int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Framebuffer.AttachTexture", attach, textarg, t, level))
+	}
+	prev := f.bindTemp(DRAW_FRAMEBUFFER)
+	C.glFramebufferTexture2D(C.GLenum(DRAW_FRAMEBUFFER), C.GLenum(attach), C.GLenum(textarg), C.GLuint(t), C.GLint(level))
+	bindFramebuffer(DRAW_FRAMEBUFFER, prev)
+}
+
+// AttachTextureLayer calls glFramebufferTextureLayer to attach a single layer of a 3D or array texture.
+func (f Framebuffer) AttachTextureLayer(attach int, t Texture, level int, layer int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Framebuffer.AttachTextureLayer", attach, t, level, layer))
+	}
+	prev := f.bindTemp(DRAW_FRAMEBUFFER)
+	C.glFramebufferTextureLayer(C.GLenum(DRAW_FRAMEBUFFER), C.GLenum(attach), C.GLuint(t), C.GLint(level), C.GLint(layer))
+	bindFramebuffer(DRAW_FRAMEBUFFER, prev)
+}
+
+// AttachRenderbuffer calls glFramebufferRenderbuffer. attach is the same as for AttachTexture.
+func (f Framebuffer) AttachRenderbuffer(attach int, r Renderbuffer) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Framebuffer.AttachRenderbuffer", attach, r))
+	}
+	prev := f.bindTemp(DRAW_FRAMEBUFFER)
+	C.glFramebufferRenderbuffer(C.GLenum(DRAW_FRAMEBUFFER), C.GLenum(attach), RENDERBUFFER, C.GLuint(r))
+	bindFramebuffer(DRAW_FRAMEBUFFER, prev)
+}
+
+// DrawBuffers calls glDrawBuffers to select the color attachments written to by fragment shader outputs.
+func (f Framebuffer) DrawBuffers(bufs ...int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Framebuffer.DrawBuffers", bufs))
+	}
+	b := make([]C.GLenum, len(bufs)+1)
+	for i, v := range bufs {
+		b[i] = C.GLenum(v)
+	}
+	prev := f.bindTemp(DRAW_FRAMEBUFFER)
+	C.glDrawBuffers(C.GLsizei(len(bufs)), &b[0])
+	bindFramebuffer(DRAW_FRAMEBUFFER, prev)
+}
+
+// ReadBuffer calls glReadBuffer to select the color attachment ReadPixels reads from while the framebuffer is bound to READ_FRAMEBUFFER.
+func (f Framebuffer) ReadBuffer(mode int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Framebuffer.ReadBuffer", mode))
+	}
+	prev := f.bindTemp(READ_FRAMEBUFFER)
+	C.glReadBuffer(C.GLenum(mode))
+	bindFramebuffer(READ_FRAMEBUFFER, prev)
+}
+
+// Check calls glCheckFramebufferStatus and returns nil if the framebuffer is complete or an error describing the problem otherwise.
+func (f Framebuffer) Check() error {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Framebuffer.Check"))
+	}
+	prev := f.bindTemp(DRAW_FRAMEBUFFER)
+	s := C.glCheckFramebufferStatus(C.GLenum(DRAW_FRAMEBUFFER))
+	bindFramebuffer(DRAW_FRAMEBUFFER, prev)
+	if s == FRAMEBUFFER_COMPLETE {
+		return nil
+	}
+	if err, ok := framebufferStatus[s]; ok {
+		return err
+	}
+	return errors.New("framebuffer incomplete: unknown status")
+}
+
+// NewRenderbuffer creates a new renderbuffer object using glGenRenderbuffers and allocates its storage with glRenderbufferStorage.
+// internalformat is e.g. RGBA8, DEPTH_COMPONENT24 or DEPTH24_STENCIL8.
+func NewRenderbuffer(internalformat int, w int, h int) Renderbuffer {
+	return NewRenderbufferMultisample(0, internalformat, w, h)
+}
+
+// NewRenderbufferMultisample is like NewRenderbuffer, but uses glRenderbufferStorageMultisample to allocate a buffer with the given number of samples.
+func NewRenderbufferMultisample(samples int, internalformat int, w int, h int) Renderbuffer {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewRenderbufferMultisample", samples, internalformat, w, h))
+	}
+	var r C.GLuint
+
+	C.glGenRenderbuffers(1, &r)
+	rr := Renderbuffer(r)
+	rr.Bind()
+	C.glRenderbufferStorageMultisample(RENDERBUFFER, C.GLsizei(samples), C.GLenum(internalformat), C.GLsizei(w), C.GLsizei(h))
+	rr.Unbind()
+	return rr
+}
+
+// Delete calls glDeleteRenderbuffers
+func (r Renderbuffer) Delete() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Renderbuffer.Delete"))
+	}
+	i := C.GLuint(r)
+	C.glDeleteRenderbuffers(1, &i)
+}
+
+// Bind calls glBindRenderbuffer
+func (r Renderbuffer) Bind() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Renderbuffer.Bind"))
+	}
+	C.glBindRenderbuffer(RENDERBUFFER, C.GLuint(r))
+}
+
+// Unbind calls glBindRenderbuffer with a 0 argument
+func (Renderbuffer) Unbind() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Renderbuffer.Unbind"))
+	}
+	C.glBindRenderbuffer(RENDERBUFFER, 0)
+}
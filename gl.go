@@ -1,86 +1,160 @@
 // The package gl provides Go bindings for OpenGL.
 // Some of the more awkward parts of the library are wrapped to provide idiomatic Go behaviour for e.g. error handling.
 // Constants have their GL_ prefix removed when possible, i.e. unless they start with a number.
-// This package uses the intersection of OpenGL 2.1 and OpenGL 3.2 core. Legacy features are not retained.
+// Constants belonging to one group, e.g. the buffer targets or the primitive modes, have a distinct type such as BufferTarget, which the wrappers take and which prints the constant's name.
+// Constants shared by several groups, e.g. ZERO and ONE, remain untyped.
+// The constants and functions are those of OpenGL 4.3 core and a few extensions, generated by glgen from the Khronos registry. Legacy features are not retained.
+// Init accepts older contexts as well, e.g. OpenGL 2.1 or 3.2 core; functions they do not provide are reported as unsupported.
+// The functions are loaded by Init from the system's OpenGL library, so no headers or extension loader are needed at build time.
+// GL errors are not checked unless a debug mode is selected with SetDebugMode.
 package gl
 
-// #cgo windows CFLAGS: -DGLEW_STATIC
-// #cgo windows LDFLAGS: -lglew32s -lopengl32
-// #cgo darwin CFLAGS: -I/opt/local/include/
-// #cgo linux LDFLAGS: -lGL -lGLEW 
-// #cgo darwin LDFLAGS: -lGLEW -L/opt/local/lib/ -framework OpenGL
-// #include <GL/glew.h>
-// #undef GLEW_GET_FUN
-// #define GLEW_GET_FUN(x) (*x)
+//go:generate go run ./glgen -registry glgen/gl.xml -version 4.3 -profile core -extensions GL_ARB_clip_control,GL_ARB_debug_output
+
+// #cgo windows LDFLAGS: -lopengl32
+// #cgo linux LDFLAGS: -ldl
+// #include <stdlib.h>
+// #include "glfuncs.h"
 import "C"
 import "unsafe"
 import "reflect"
 import "errors"
 import "image"
-import "image/color"
 import "runtime"
 
-func Init() {
+// Init locks the calling goroutine to its thread, loads the function pointers for the context current on it and queries the capabilities of the context, see GetCaps.
+// It returns an error, leaving the goroutine unlocked, if the OpenGL library cannot be loaded or there is no current context.
+// Functions the context does not provide are replaced by stubs that do nothing and make GetError return an *UnsupportedError, see Supported.
+// All further calls have to be made from the same goroutine; RenderThread helps to submit GL work from other goroutines.
+func Init() error {
 	runtime.LockOSThread()
-	C.glewInit()
+	if err := loadFuncs(); err != nil {
+		runtime.UnlockOSThread()
+		return err
+	}
+	if C.glGetString(VERSION) == nil {
+		runtime.UnlockOSThread()
+		return errors.New("gl: no current context")
+	}
+	// the remaining steps cannot fail, so the goroutine owns the context from here on
+	owner = goid()
+	debugDepth = 0
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Init"))
+	}
+	for GetError() != nil {
+	}
+	queryCaps()
+	restrictFuncs()
+	InvalidateState()
+	return nil
 }
 
 // Enable calls glEnable
-func Enable(mask int) {
+func Enable(mask Capability) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Enable", mask))
+	}
 	C.glEnable(C.GLenum(mask))
+	cache.enable(mask, true)
 }
 
 // Disable calls glDisable
-func Disable(mask int) {
+func Disable(mask Capability) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Disable", mask))
+	}
 	C.glDisable(C.GLenum(mask))
+	cache.enable(mask, false)
 }
 
 // ClearColor calls glClearColor
 func ClearColor(r float64, g float64, b float64, a float64) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("ClearColor", r, g, b, a))
+	}
 	C.glClearColor(C.GLclampf(r), C.GLclampf(g), C.GLclampf(b), C.GLclampf(a))
 }
 
 // Clear calls glClear
-func Clear(mask int) {
+func Clear(mask ClearMask) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Clear", mask))
+	}
 	C.glClear(C.GLbitfield(mask))
 }
 
 // Viewport calls glViewport
 func Viewport(x int, y int, w int, h int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Viewport", x, y, w, h))
+	}
 	C.glViewport(C.GLint(x), C.GLint(y), C.GLsizei(w), C.GLsizei(h))
 }
 
-// Add the interface to export image
+// ReadPixels calls glReadPixels to read the w by h rectangle with bottom left corner x, y from the current read buffer and returns it as an image with 16 bits per channel.
+// The image is upright, i.e. the bottom row of the rectangle becomes the last row of the image, and its bounds start at 0, 0.
 func ReadPixels(x int, y int, w int, h int) image.Image {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("ReadPixels", x, y, w, h))
+	}
+	rgba := image.NewRGBA64(image.Rect(0, 0, w, h))
+	if w <= 0 || h <= 0 {
+		return rgba
+	}
 	data := make([]uint16, 4*w*h)
-	p := unsafe.Pointer(&data[0])
-	C.glReadPixels(C.GLint(x), C.GLint(y), C.GLsizei(w), C.GLsizei(h), C.GLenum(RGBA), C.GLenum(UNSIGNED_SHORT), p)
-	rec := image.Rect(x, y, x+w, y+h)
-	rgba := image.NewRGBA64(rec)
-	for i := 0; i < w*h; i++ {
-		c := color.RGBA64{data[4*i], data[4*i+1], data[4*i+2], data[4*i+3]}
-		rgba.Set(i%w, h-i/w, c)
+	C.glPixelStorei(PACK_ALIGNMENT, 1)
+	C.glReadPixels(C.GLint(x), C.GLint(y), C.GLsizei(w), C.GLsizei(h), C.GLenum(RGBA), C.GLenum(UNSIGNED_SHORT), unsafe.Pointer(&data[0]))
+	for i := 0; i < h; i++ {
+		row := rgba.Pix[(h-1-i)*rgba.Stride:]
+		for j, c := range data[4*w*i : 4*w*(i+1)] {
+			row[2*j] = uint8(c >> 8)
+			row[2*j+1] = uint8(c)
+		}
 	}
 	return rgba
 }
 
 // DepthRange calls glDepthRange
 func DepthRange(zNear, zFar float64) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DepthRange", zNear, zFar))
+	}
 	C.glDepthRange(C.GLclampd(zNear), C.GLclampd(zFar))
 }
 
+// ClipControl calls glClipControl. ClipControl(LOWER_LEFT, ZERO_TO_ONE) selects the depth range of 0 to 1 expected by the reversed-Z projections such as PerspectiveReversedZ.
+// It requires OpenGL 4.5 or ARB_clip_control.
+func ClipControl(origin, depth int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("ClipControl", origin, depth))
+	}
+	C.glClipControl(C.GLenum(origin), C.GLenum(depth))
+}
+
 // BlendFunc calls glBlendFunc
-func BlendFunc(sfactor, dfactor int) {
+func BlendFunc(sfactor, dfactor BlendFactor) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("BlendFunc", sfactor, dfactor))
+	}
 	C.glBlendFunc(C.GLenum(sfactor), C.GLenum(dfactor))
+	r := &cache.render
+	r.BlendSrcRGB, r.BlendDstRGB, r.BlendSrcAlpha, r.BlendDstAlpha = sfactor, dfactor, sfactor, dfactor
 }
 
 // PolygonMode calls glPolygonMode
-func PolygonMode(face, mode int) {
+func PolygonMode(face Face, mode int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("PolygonMode", face, mode))
+	}
 	C.glPolygonMode(C.GLenum(face), C.GLenum(mode))
 }
 
 // ColorMask calls glColorMask
 func ColorMask(r, g, b, a bool) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("ColorMask", r, g, b, a))
+	}
 	R, G, B, A := FALSE, FALSE, FALSE, FALSE
 	if r {
 		R = TRUE
@@ -95,6 +169,192 @@ func ColorMask(r, g, b, a bool) {
 		A = TRUE
 	}
 	C.glColorMask(C.GLboolean(R), C.GLboolean(G), C.GLboolean(B), C.GLboolean(A))
+	cache.render.ColorMask = [4]bool{r, g, b, a}
+}
+
+// BlendFuncSeparate calls glBlendFuncSeparate
+func BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha BlendFactor) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("BlendFuncSeparate", srcRGB, dstRGB, srcAlpha, dstAlpha))
+	}
+	C.glBlendFuncSeparate(C.GLenum(srcRGB), C.GLenum(dstRGB), C.GLenum(srcAlpha), C.GLenum(dstAlpha))
+	r := &cache.render
+	r.BlendSrcRGB, r.BlendDstRGB, r.BlendSrcAlpha, r.BlendDstAlpha = srcRGB, dstRGB, srcAlpha, dstAlpha
+}
+
+// BlendEquation calls glBlendEquation
+func BlendEquation(mode BlendOp) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("BlendEquation", mode))
+	}
+	C.glBlendEquation(C.GLenum(mode))
+	cache.render.BlendOpRGB, cache.render.BlendOpAlpha = mode, mode
+}
+
+// BlendEquationSeparate calls glBlendEquationSeparate
+func BlendEquationSeparate(modeRGB, modeAlpha BlendOp) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("BlendEquationSeparate", modeRGB, modeAlpha))
+	}
+	C.glBlendEquationSeparate(C.GLenum(modeRGB), C.GLenum(modeAlpha))
+	cache.render.BlendOpRGB, cache.render.BlendOpAlpha = modeRGB, modeAlpha
+}
+
+// BlendColor calls glBlendColor
+func BlendColor(r, g, b, a float64) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("BlendColor", r, g, b, a))
+	}
+	C.glBlendColor(C.GLfloat(r), C.GLfloat(g), C.GLfloat(b), C.GLfloat(a))
+	cache.render.BlendColor = [4]float32{float32(r), float32(g), float32(b), float32(a)}
+}
+
+// DepthFunc calls glDepthFunc
+func DepthFunc(f CompareFunc) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DepthFunc", f))
+	}
+	C.glDepthFunc(C.GLenum(f))
+	cache.render.DepthFunc = f
+}
+
+// DepthMask calls glDepthMask
+func DepthMask(write bool) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DepthMask", write))
+	}
+	W := FALSE
+	if write {
+		W = TRUE
+	}
+	C.glDepthMask(C.GLboolean(W))
+	cache.render.DepthWrite = write
+}
+
+// StencilFunc calls glStencilFunc
+func StencilFunc(f CompareFunc, ref int, mask uint32) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("StencilFunc", f, ref, mask))
+	}
+	C.glStencilFunc(C.GLenum(f), C.GLint(ref), C.GLuint(mask))
+	for _, s := range cache.stencil(FRONT_AND_BACK) {
+		s.Func, s.Ref, s.Mask = f, ref, mask
+	}
+}
+
+// StencilFuncSeparate calls glStencilFuncSeparate
+func StencilFuncSeparate(face Face, f CompareFunc, ref int, mask uint32) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("StencilFuncSeparate", face, f, ref, mask))
+	}
+	C.glStencilFuncSeparate(C.GLenum(face), C.GLenum(f), C.GLint(ref), C.GLuint(mask))
+	for _, s := range cache.stencil(face) {
+		s.Func, s.Ref, s.Mask = f, ref, mask
+	}
+}
+
+// StencilOp calls glStencilOp
+func StencilOp(sfail, dpfail, dppass StencilAction) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("StencilOp", sfail, dpfail, dppass))
+	}
+	C.glStencilOp(C.GLenum(sfail), C.GLenum(dpfail), C.GLenum(dppass))
+	for _, s := range cache.stencil(FRONT_AND_BACK) {
+		s.SFail, s.DPFail, s.DPPass = sfail, dpfail, dppass
+	}
+}
+
+// StencilOpSeparate calls glStencilOpSeparate
+func StencilOpSeparate(face Face, sfail, dpfail, dppass StencilAction) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("StencilOpSeparate", face, sfail, dpfail, dppass))
+	}
+	C.glStencilOpSeparate(C.GLenum(face), C.GLenum(sfail), C.GLenum(dpfail), C.GLenum(dppass))
+	for _, s := range cache.stencil(face) {
+		s.SFail, s.DPFail, s.DPPass = sfail, dpfail, dppass
+	}
+}
+
+// StencilMask calls glStencilMask
+func StencilMask(mask uint32) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("StencilMask", mask))
+	}
+	C.glStencilMask(C.GLuint(mask))
+	for _, s := range cache.stencil(FRONT_AND_BACK) {
+		s.WriteMask = mask
+	}
+}
+
+// StencilMaskSeparate calls glStencilMaskSeparate
+func StencilMaskSeparate(face Face, mask uint32) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("StencilMaskSeparate", face, mask))
+	}
+	C.glStencilMaskSeparate(C.GLenum(face), C.GLuint(mask))
+	for _, s := range cache.stencil(face) {
+		s.WriteMask = mask
+	}
+}
+
+// Scissor calls glScissor. The scissor test is enabled with Enable(SCISSOR_TEST).
+func Scissor(x int, y int, w int, h int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Scissor", x, y, w, h))
+	}
+	C.glScissor(C.GLint(x), C.GLint(y), C.GLsizei(w), C.GLsizei(h))
+	cache.render.Scissor = [4]int{x, y, w, h}
+}
+
+// CullFace calls glCullFace. Culling is enabled with Enable(CULL_FACE).
+func CullFace(face Face) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("CullFace", face))
+	}
+	C.glCullFace(C.GLenum(face))
+	cache.render.CullFace = face
+}
+
+// FrontFace calls glFrontFace
+func FrontFace(mode Winding) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("FrontFace", mode))
+	}
+	C.glFrontFace(C.GLenum(mode))
+	cache.render.FrontFace = mode
+}
+
+// LineWidth calls glLineWidth. Core profiles only support widths above 1 if the implementation provides wide lines.
+func LineWidth(width float64) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("LineWidth", width))
+	}
+	C.glLineWidth(C.GLfloat(width))
+}
+
+// PolygonOffset calls glPolygonOffset. The offset is enabled with e.g. Enable(POLYGON_OFFSET_FILL).
+func PolygonOffset(factor, units float64) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("PolygonOffset", factor, units))
+	}
+	C.glPolygonOffset(C.GLfloat(factor), C.GLfloat(units))
+	cache.render.PolygonOffsetFactor, cache.render.PolygonOffsetUnits = float32(factor), float32(units)
+}
+
+// ClearDepth calls glClearDepth
+func ClearDepth(d float64) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("ClearDepth", d))
+	}
+	C.glClearDepth(C.GLdouble(d))
+}
+
+// ClearStencil calls glClearStencil
+func ClearStencil(s int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("ClearStencil", s))
+	}
+	C.glClearStencil(C.GLint(s))
 }
 
 func toCtype(data interface{}) (p unsafe.Pointer, t C.GLenum, ts int, s uintptr) {
@@ -141,13 +401,18 @@ type Buffer struct {
 	i  C.GLuint
 	t  C.GLenum
 	ts int
+	s  uintptr
 }
 
 // NewBuffer creates a new buffer using glGenBuffers. If targ is not 0, it will call Buffer.Set with the given parameters.
-func NewBuffer(targ int, data interface{}, usage int) *Buffer {
+func NewBuffer(targ BufferTarget, data interface{}, usage BufferUsage) *Buffer {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewBuffer", targ, data, usage))
+	}
 	var buf C.GLuint
 
 	C.glGenBuffers(1, &buf)
+	trackObject(BUFFER, buf)
 	buff := &Buffer{}
 	buff.i = buf
 	if targ != 0 {
@@ -158,22 +423,80 @@ func NewBuffer(targ int, data interface{}, usage int) *Buffer {
 
 //DeleteBuffer delete the buffer using glDeleteBuffer
 func DeleteBuffers(buffers ...*Buffer) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DeleteBuffers", buffers))
+	}
 	for _, buf := range buffers {
-		C.glDeleteBuffers(1, &(buf.i))
+		buf.Delete()
+	}
+}
+
+// Delete calls glDeleteBuffers
+func (buf *Buffer) Delete() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.Delete"))
 	}
+	untrackObject(BUFFER, buf.i)
+	forgetBuffer(buf.i)
+	C.glDeleteBuffers(1, &buf.i)
 }
 
 // Set calls glBufferData with appropriate arguments to load the data pointed to by data into the buffer. usage is passed along verbatim. targ is used for binding and it should most likely be ARRAY_BUFFER.
-func (buf *Buffer) Set(targ int, data interface{}, usage int) {
+func (buf *Buffer) Set(targ BufferTarget, data interface{}, usage BufferUsage) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.Set", targ, data, usage))
+	}
 	buf.Bind(targ)
 	p, t, ts, s := toCtype(data)
 	C.glBufferData(C.GLenum(targ), C.GLsizeiptr(s), p, C.GLenum(usage))
 	buf.t = t
 	buf.ts = ts
+	buf.s = s
 	buf.Unbind(targ)
 }
 
+// Alloc calls glBufferData to allocate size bytes of uninitialized storage for the buffer, e.g. to serve as the destination of ReadPixelsAsync.
+// The buffer is treated as holding bytes afterwards.
+func (buf *Buffer) Alloc(targ BufferTarget, size int, usage BufferUsage) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.Alloc", targ, size, usage))
+	}
+	buf.Bind(targ)
+	C.glBufferData(C.GLenum(targ), C.GLsizeiptr(size), nil, C.GLenum(usage))
+	buf.t = UNSIGNED_BYTE
+	buf.ts = 1
+	buf.s = uintptr(size)
+	buf.Unbind(targ)
+}
+
+// Map calls glMapBufferRange to map the entire buffer into memory and returns the mapped memory, or nil on failure. access is a combination of MAP_READ_BIT, MAP_WRITE_BIT etc.
+// The buffer remains bound to targ until Unmap is called and the returned slice must not be used afterwards.
+func (buf *Buffer) Map(targ BufferTarget, access int) []byte {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.Map", targ, access))
+	}
+	buf.Bind(targ)
+	p := C.glMapBufferRange(C.GLenum(targ), 0, C.GLsizeiptr(buf.s), C.GLbitfield(access))
+	if p == nil {
+		return nil
+	}
+	return unsafe.Slice((*byte)(p), buf.s)
+}
+
+// Unmap calls glUnmapBuffer and unbinds the buffer. It returns false if the contents of the buffer were corrupted while it was mapped.
+func (buf *Buffer) Unmap(targ BufferTarget) bool {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.Unmap", targ))
+	}
+	r := C.glUnmapBuffer(C.GLenum(targ))
+	buf.Unbind(targ)
+	return r == TRUE
+}
+
 func GetIntegerv(targ int, size int) (data []int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("GetIntegerv", targ, size))
+	}
 	data = make([]int, 4)
 	var p []C.GLint = make([]C.GLint, size)
 	C.glGetIntegerv(C.GLenum(targ), &p[0])
@@ -183,25 +506,65 @@ func GetIntegerv(targ int, size int) (data []int) {
 	return
 }
 
-// Bind calls glBindBuffer
-func (buf *Buffer) Bind(targ int) {
-	C.glBindBuffer(C.GLenum(targ), buf.i)
+// Bind calls glBindBuffer unless the buffer is already bound to targ
+func (buf *Buffer) Bind(targ BufferTarget) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.Bind", targ))
+	}
+	bindBuffer(targ, buf.i)
+}
+
+// Unbind calls glBindBuffer with a 0 argument unless no buffer is bound to targ
+func (*Buffer) Unbind(targ BufferTarget) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.Unbind", targ))
+	}
+	bindBuffer(targ, 0)
+}
+
+// SetSub calls glBufferSubData to replace part of the buffer's contents with data. offset is in units of elements of data.
+func (buf *Buffer) SetSub(targ BufferTarget, offset int, data interface{}) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.SetSub", targ, offset, data))
+	}
+	buf.Bind(targ)
+	p, _, ts, s := toCtype(data)
+	C.glBufferSubData(C.GLenum(targ), C.GLintptr(offset*ts), C.GLsizeiptr(s), p)
+	buf.Unbind(targ)
+}
+
+// BindBase calls glBindBufferBase to attach the buffer to an indexed binding point of targ, which should be UNIFORM_BUFFER or TRANSFORM_FEEDBACK_BUFFER.
+func (buf *Buffer) BindBase(targ BufferTarget, index int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.BindBase", targ, index))
+	}
+	C.glBindBufferBase(C.GLenum(targ), C.GLuint(index), buf.i)
+	// the generic binding point changes as well
+	cache.buffers[targ] = buf.i
 }
 
-// Unbind calls glBindBuffer with a 0 argument
-func (*Buffer) Unbind(targ int) {
-	C.glBindBuffer(C.GLenum(targ), 0)
+// BindRange calls glBindBufferRange to attach part of the buffer to an indexed binding point. offset and size are in units of array elements, like for Program.EnableAttrib.
+func (buf *Buffer) BindRange(targ BufferTarget, index int, offset int, size int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Buffer.BindRange", targ, index, offset, size))
+	}
+	C.glBindBufferRange(C.GLenum(targ), C.GLuint(index), buf.i, C.GLintptr(offset*buf.ts), C.GLsizeiptr(size*buf.ts))
+	cache.buffers[targ] = buf.i
 }
 
 // The type Shader represents a shader.
 type Shader C.GLuint
 
 // NewShader creates a shader object of type typ, loads it with source code src and compiles it
-func NewShader(typ int, src string) (Shader, error) {
+func NewShader(typ ShaderType, src string) (Shader, error) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewShader", typ, src))
+	}
 	var val C.GLint
 	shad := C.glCreateShader(C.GLenum(typ))
 	s := (*C.GLchar)(C.CString(src))
 	C.glShaderSource(shad, 1, &s, nil)
+	C.free(unsafe.Pointer(s))
 	C.glCompileShader(shad)
 	C.glGetShaderiv(shad, COMPILE_STATUS, &val)
 	if val != TRUE {
@@ -211,48 +574,97 @@ func NewShader(typ int, src string) (Shader, error) {
 		C.glDeleteShader(shad)
 		return Shader(0), errors.New(C.GoString((*C.char)(&buf[0])))
 	}
+	trackObject(SHADER, shad)
 	return Shader(shad), nil
 }
 
+// Delete calls glDeleteShader. A shader attached to a program is only deleted once it is detached.
+func (s Shader) Delete() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Shader.Delete"))
+	}
+	untrackObject(SHADER, C.GLuint(s))
+	C.glDeleteShader(C.GLuint(s))
+}
+
 // The type Program represents a shader program. It contains maps to cache the location of attributes and uniforms.
 type Program struct {
-	i    C.GLuint
-	attr map[string]C.GLuint
-	uni  map[string]C.GLint
+	i      C.GLuint
+	attr   map[string]C.GLuint
+	cols   map[string]int
+	uni    map[string]C.GLint
+	blocks map[string]*UniformBlock
+}
+
+// attribColumns gives the number of consecutive locations used by matrix attributes
+var attribColumns = map[C.GLenum]int{
+	FLOAT_MAT2:   2,
+	FLOAT_MAT2x3: 2,
+	FLOAT_MAT2x4: 2,
+	FLOAT_MAT3:   3,
+	FLOAT_MAT3x2: 3,
+	FLOAT_MAT3x4: 3,
+	FLOAT_MAT4:   4,
+	FLOAT_MAT4x2: 4,
+	FLOAT_MAT4x3: 4,
 }
 
 // NewProgram creates an empty program
 func NewProgram() *Program {
-	return &Program{i: C.glCreateProgram()}
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewProgram"))
+	}
+	p := &Program{i: C.glCreateProgram()}
+	trackObject(PROGRAM, p.i)
+	return p
 }
 
 // Attach attaches a shader object
 func (p *Program) Attach(s Shader) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.Attach", s))
+	}
 	C.glAttachShader(p.i, C.GLuint(s))
 }
 
 // Detach detaches a shader object
 func (p *Program) Detach(s Shader) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.Detach", s))
+	}
 	C.glDetachShader(p.i, C.GLuint(s))
 }
 
 // Delete deletes the program object
 func (p *Program) Delete() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.Delete"))
+	}
+	untrackObject(PROGRAM, p.i)
 	C.glDeleteProgram(p.i)
 }
 
-// Use calls glUseProgram
+// Use calls glUseProgram unless the program is already in use
 func (p *Program) Use() {
-	C.glUseProgram(p.i)
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.Use"))
+	}
+	useProgram(p.i)
 }
 
-// Unuse calls glUseProgram with a 0 argument
+// Unuse calls glUseProgram with a 0 argument unless no program is in use
 func (p *Program) Unuse() {
-	C.glUseProgram(C.GLuint(0))
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.Unuse"))
+	}
+	useProgram(0)
 }
 
 // Link links the attached shader objects
 func (p *Program) Link() error {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.Link"))
+	}
 	var val, val2 C.GLint
 	var dummys C.GLsizei
 	var dummyi C.GLint
@@ -266,12 +678,16 @@ func (p *Program) Link() error {
 		return errors.New(C.GoString((*C.char)(&buf[0])))
 	}
 	p.attr = make(map[string]C.GLuint)
+	p.cols = make(map[string]int)
 	C.glGetProgramiv(p.i, ACTIVE_ATTRIBUTES, &val)
 	C.glGetProgramiv(p.i, ACTIVE_ATTRIBUTE_MAX_LENGTH, &val2)
 	buf := make([]C.char, val2)
 	for i := C.GLuint(0); i < C.GLuint(val); i++ {
 		C.glGetActiveAttrib(p.i, i, C.GLsizei(val2), &dummys, &dummyi, &dummye, (*C.GLchar)(&buf[0]))
 		p.attr[C.GoString(&buf[0])] = C.GLuint(C.glGetAttribLocation(p.i, (*C.GLchar)(&buf[0])))
+		if c, ok := attribColumns[dummye]; ok {
+			p.cols[C.GoString(&buf[0])] = c
+		}
 	}
 	p.uni = make(map[string]C.GLint)
 	C.glGetProgramiv(p.i, ACTIVE_UNIFORMS, &val)
@@ -279,38 +695,77 @@ func (p *Program) Link() error {
 	buf = make([]C.char, val2)
 	for i := C.GLuint(0); i < C.GLuint(val); i++ {
 		C.glGetActiveUniform(p.i, i, C.GLsizei(val2), &dummys, &dummyi, &dummye, (*C.GLchar)(&buf[0]))
-		p.uni[C.GoString(&buf[0])] = C.glGetUniformLocation(p.i, (*C.GLchar)(&buf[0]))
+		// members of uniform blocks have no location
+		if l := C.glGetUniformLocation(p.i, (*C.GLchar)(&buf[0])); l >= 0 {
+			p.uni[C.GoString(&buf[0])] = l
+		}
 	}
+	p.reflectBlocks()
 	return nil
 }
 
 // EnableAttrib calls glEnableVertexAttribArray and glVertexAttribPointer to activate an attribute and connect it to a buffer object.
 // offset specifies the first vertex, stride specifies the distance from the beginning of one vertex to the next, size specifies the number of components in a vertex (all these arguments are in units of array elements, not bytes like the underlying API).
 // The byte offset of component j of vertex i is thus calculated as: sizeof(data[0]) * (offset + stride * i + j), where data is the parameter passed to Buffer.Set
+// For matrix attributes size is the total number of components and each column is read as size/columns consecutive components, e.g. a mat4 takes 16 components in column-major order.
 func (p *Program) EnableAttrib(loc string, buf *Buffer, offset int, size int, stride int, norm bool) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.EnableAttrib", loc, buf, offset, size, stride, norm))
+	}
 	n := FALSE
 	if norm {
 		n = TRUE
 	}
 	buf.Bind(ARRAY_BUFFER)
 	if attr, ok := p.attr[loc]; ok {
-		C.glEnableVertexAttribArray(attr)
-		C.glVertexAttribPointer(attr, C.GLint(size), buf.t, C.GLboolean(n), C.GLsizei(stride*buf.ts), unsafe.Pointer(uintptr(buf.ts*offset)))
+		cols := p.columns(loc)
+		size /= cols
+		for c := 0; c < cols; c++ {
+			C.glEnableVertexAttribArray(attr + C.GLuint(c))
+			C.glVertexAttribPointer(attr+C.GLuint(c), C.GLint(size), buf.t, C.GLboolean(n), C.GLsizei(stride*buf.ts), bufferOffset(buf.ts*(offset+c*size)))
+		}
 	}
 	buf.Unbind(ARRAY_BUFFER)
 }
 
 // DisableAttrib calls glDisableVertexAttribArray
 func (p *Program) DisableAttrib(loc string) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.DisableAttrib", loc))
+	}
+	if attr, ok := p.attr[loc]; ok {
+		for c := 0; c < p.columns(loc); c++ {
+			C.glDisableVertexAttribArray(attr + C.GLuint(c))
+		}
+	}
+}
+
+// AttribDivisor calls glVertexAttribDivisor. If divisor is not 0, the attribute advances once every divisor instances instead of once per vertex.
+func (p *Program) AttribDivisor(loc string, divisor int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.AttribDivisor", loc, divisor))
+	}
 	if attr, ok := p.attr[loc]; ok {
-		C.glDisableVertexAttribArray(attr)
-	}	
+		for c := 0; c < p.columns(loc); c++ {
+			C.glVertexAttribDivisor(attr+C.GLuint(c), C.GLuint(divisor))
+		}
+	}
+}
+
+func (p *Program) columns(loc string) int {
+	if c, ok := p.cols[loc]; ok {
+		return c
+	}
+	return 1
 }
 
-// SetUniform sets a uniform variable using the appropriate glUniform* or glUniformMatrix* call. It supports arrays of float32 and float64 or Mat4 objects.
+// SetUniform sets a uniform variable using the appropriate glUniform* or glUniformMatrix* call. It supports arrays of float32 and float64, Vec2, Vec3, Vec4, Quat (as a vec4) or Mat2, Mat3, Mat4 and non-square matrix objects.
+// Two-dimensional arrays are matrices indexed by row and then column, e.g. [3][2]float32 sets a mat2x3, like Mat2x3.
 // NB: The underlying API does not support double precision, being able to pass float64 values is for convenience only.
-// BUG: It does not support non-square matrices.
 func (p *Program) SetUniform(loc string, data interface{}) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.SetUniform", loc, data))
+	}
 	uni, ok := p.uni[loc]
 	if !ok {
 		return
@@ -336,6 +791,14 @@ func (p *Program) SetUniform(loc string, data interface{}) {
 		C.glUniform3f(uni, C.GLfloat(f[0]), C.GLfloat(f[1]), C.GLfloat(f[2]))
 	case [4]float64:
 		C.glUniform4f(uni, C.GLfloat(f[0]), C.GLfloat(f[1]), C.GLfloat(f[2]), C.GLfloat(f[3]))
+	case Vec2:
+		C.glUniform2f(uni, C.GLfloat(f[0]), C.GLfloat(f[1]))
+	case Vec3:
+		C.glUniform3f(uni, C.GLfloat(f[0]), C.GLfloat(f[1]), C.GLfloat(f[2]))
+	case Vec4:
+		C.glUniform4f(uni, C.GLfloat(f[0]), C.GLfloat(f[1]), C.GLfloat(f[2]), C.GLfloat(f[3]))
+	case Quat:
+		C.glUniform4f(uni, C.GLfloat(f[0]), C.GLfloat(f[1]), C.GLfloat(f[2]), C.GLfloat(f[3]))
 	case int:
 		C.glUniform1i(uni, C.GLint(f))
 	case [1]int:
@@ -367,31 +830,67 @@ func (p *Program) SetUniform(loc string, data interface{}) {
 	case Mat4:
 		g := [16]C.GLfloat{C.GLfloat(f[0][0]), C.GLfloat(f[1][0]), C.GLfloat(f[2][0]), C.GLfloat(f[3][0]), C.GLfloat(f[0][1]), C.GLfloat(f[1][1]), C.GLfloat(f[2][1]), C.GLfloat(f[3][1]), C.GLfloat(f[0][2]), C.GLfloat(f[1][2]), C.GLfloat(f[2][2]), C.GLfloat(f[3][2]), C.GLfloat(f[0][3]), C.GLfloat(f[1][3]), C.GLfloat(f[2][3]), C.GLfloat(f[3][3])}
 		C.glUniformMatrix4fv(uni, 1, FALSE, &g[0])
+	case Mat2:
+		C.glUniformMatrix2fv(uni, 1, FALSE, &colMajor(f)[0])
+	case Mat3:
+		C.glUniformMatrix3fv(uni, 1, FALSE, &colMajor(f)[0])
+	case Mat2x3, [3][2]float32, [3][2]float64:
+		C.glUniformMatrix2x3fv(uni, 1, FALSE, &colMajor(f)[0])
+	case Mat3x2, [2][3]float32, [2][3]float64:
+		C.glUniformMatrix3x2fv(uni, 1, FALSE, &colMajor(f)[0])
+	case Mat2x4, [4][2]float32, [4][2]float64:
+		C.glUniformMatrix2x4fv(uni, 1, FALSE, &colMajor(f)[0])
+	case Mat4x2, [2][4]float32, [2][4]float64:
+		C.glUniformMatrix4x2fv(uni, 1, FALSE, &colMajor(f)[0])
+	case Mat3x4, [4][3]float32, [4][3]float64:
+		C.glUniformMatrix3x4fv(uni, 1, FALSE, &colMajor(f)[0])
+	case Mat4x3, [3][4]float32, [3][4]float64:
+		C.glUniformMatrix4x3fv(uni, 1, FALSE, &colMajor(f)[0])
 	default:
 		panic("invalid type passed to SetUniform()")
 	}
 }
 
+// colMajor returns the elements of a matrix, a two-dimensional array of floats indexed by row and then column, in the column-major order expected by glUniformMatrix*.
+func colMajor(m interface{}) []C.GLfloat {
+	v := reflect.ValueOf(m)
+	rows, cols := v.Len(), v.Index(0).Len()
+	g := make([]C.GLfloat, rows*cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			g[c*rows+r] = C.GLfloat(v.Index(r).Index(c).Float())
+		}
+	}
+	return g
+}
+
 // MakeProgram is a convenience routine which calls NewProgram(), NewShader(), Shader.Attach() and Program.Link() to create a shader program object.
+// The shaders are detached and deleted afterwards, since the linked program does not need them.
 func MakeProgram(vertex []string, fragment []string) (*Program, error) {
 	p := NewProgram()
-	for _, s := range vertex {
-		shad, err := NewShader(VERTEX_SHADER, s)
-		if err != nil {
-			p.Delete()
-			return nil, err
+	var shaders []Shader
+	compile := func(typ ShaderType, src []string) error {
+		for _, s := range src {
+			shad, err := NewShader(typ, s)
+			if err != nil {
+				return err
+			}
+			p.Attach(shad)
+			shaders = append(shaders, shad)
 		}
-		p.Attach(shad)
+		return nil
 	}
-	for _, s := range fragment {
-		shad, err := NewShader(FRAGMENT_SHADER, s)
-		if err != nil {
-			p.Delete()
-			return nil, err
-		}
-		p.Attach(shad)
+	err := compile(VERTEX_SHADER, vertex)
+	if err == nil {
+		err = compile(FRAGMENT_SHADER, fragment)
+	}
+	if err == nil {
+		err = p.Link()
+	}
+	for _, s := range shaders {
+		p.Detach(s)
+		s.Delete()
 	}
-	err := p.Link()
 	if err != nil {
 		p.Delete()
 		return nil, err
@@ -400,64 +899,144 @@ func MakeProgram(vertex []string, fragment []string) (*Program, error) {
 }
 
 // DrawArrays calls glDrawArrays
-func DrawArrays(mode, first, count int) {
+func DrawArrays(mode PrimitiveMode, first, count int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DrawArrays", mode, first, count))
+	}
 	C.glDrawArrays(C.GLenum(mode), C.GLint(first), C.GLsizei(count))
 }
 
+// DrawArraysInstanced calls glDrawArraysInstanced
+func DrawArraysInstanced(mode PrimitiveMode, first, count, instances int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DrawArraysInstanced", mode, first, count, instances))
+	}
+	C.glDrawArraysInstanced(C.GLenum(mode), C.GLint(first), C.GLsizei(count), C.GLsizei(instances))
+}
+
+func (buf *Buffer) indexType() C.GLenum {
+	switch buf.t {
+	case UNSIGNED_BYTE, UNSIGNED_SHORT, UNSIGNED_INT:
+		return buf.t
+	}
+	panic("element buffer is not of type uint8, uint16 or uint32")
+}
+
+// bufferOffset converts a byte offset into the bound buffer object to the pointer argument that takes its place in the underlying API.
+// unsafe.Add avoids the conversion from uintptr, which go vet reports as a possible misuse of unsafe.Pointer, and nocheckptr keeps -race from rejecting the result, which is not a valid Go pointer.
+//
+//go:nocheckptr
+func bufferOffset(offset int) unsafe.Pointer {
+	return unsafe.Add(nil, offset)
+}
+
+// DrawElements calls glDrawElements with elem bound to ELEMENT_ARRAY_BUFFER. The index type is that of the slice passed to Buffer.Set, which must be uint8, uint16 or uint32.
+// offset specifies the first index and count the number of indices (in units of indices, not bytes like the underlying API).
+// elem is left bound since the binding is part of the vertex array state; when drawing with a VertexArray, pass VertexArray.Elements.
+func DrawElements(mode PrimitiveMode, elem *Buffer, offset int, count int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DrawElements", mode, elem, offset, count))
+	}
+	t := elem.indexType()
+	elem.Bind(ELEMENT_ARRAY_BUFFER)
+	C.glDrawElements(C.GLenum(mode), C.GLsizei(count), t, bufferOffset(elem.ts*offset))
+}
+
+// DrawElementsInstanced calls glDrawElementsInstanced. It is like DrawElements, but draws the given number of instances.
+func DrawElementsInstanced(mode PrimitiveMode, elem *Buffer, offset int, count int, instances int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DrawElementsInstanced", mode, elem, offset, count, instances))
+	}
+	t := elem.indexType()
+	elem.Bind(ELEMENT_ARRAY_BUFFER)
+	C.glDrawElementsInstanced(C.GLenum(mode), C.GLsizei(count), t, bufferOffset(elem.ts*offset), C.GLsizei(instances))
+}
+
+// DrawRangeElements calls glDrawRangeElements. It is like DrawElements, but additionally specifies the range [start, end] of vertices referenced by the indices.
+func DrawRangeElements(mode PrimitiveMode, elem *Buffer, start int, end int, offset int, count int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DrawRangeElements", mode, elem, start, end, offset, count))
+	}
+	t := elem.indexType()
+	elem.Bind(ELEMENT_ARRAY_BUFFER)
+	C.glDrawRangeElements(C.GLenum(mode), C.GLuint(start), C.GLuint(end), C.GLsizei(count), t, bufferOffset(elem.ts*offset))
+}
+
+// DrawElementsBaseVertex calls glDrawElementsBaseVertex. It is like DrawElements, but adds basevertex to each index before fetching the vertex.
+func DrawElementsBaseVertex(mode PrimitiveMode, elem *Buffer, offset int, count int, basevertex int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("DrawElementsBaseVertex", mode, elem, offset, count, basevertex))
+	}
+	t := elem.indexType()
+	elem.Bind(ELEMENT_ARRAY_BUFFER)
+	C.glDrawElementsBaseVertex(C.GLenum(mode), C.GLsizei(count), t, bufferOffset(elem.ts*offset), C.GLint(basevertex))
+}
+
 // The type Texture represents a texture object.
 type Texture C.GLuint
 
-// NewTexture2D creates a new texture object from the given image using glTexImage2D. It uses RGBA as a color format and sets GL_TEXTURE_{MIN,MAG}_FILTER to GL_NEAREST
+// NewTexture2D creates a new texture object from the given image using glTexImage2D and sets GL_TEXTURE_{MIN,MAG}_FILTER to GL_NEAREST.
+// The image is flipped so that it appears upright with texture coordinate (0, 0) at its bottom left corner.
+// It uses RGBA8 as a color format, or R8 for *image.Gray, whose channel is swizzled so that it samples as gray (if texture swizzling is not supported, *image.Gray is converted to RGBA8).
+// *image.RGBA, *image.NRGBA and *image.Gray are loaded directly from their pixel data; note that for *image.NRGBA this means the colors are not premultiplied by alpha.
+// *image.YCbCr, as returned by image/jpeg, is converted to RGBA directly; other images are converted with draw.Draw.
 func NewTexture2D(img image.Image, border int) Texture {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewTexture2D", img, border))
+	}
 	var t C.GLuint
 
 	C.glGenTextures(1, &t)
+	trackObject(TEXTURE, t)
 	tt := Texture(t)
 	tt.Bind(TEXTURE_2D)
-	data := make([]uint16, img.Bounds().Dx()*img.Bounds().Dy()*4)
-	r := img.Bounds()
-	for x := r.Min.X; x < r.Max.X; x++ {
-		for y := r.Min.Y; y < r.Max.Y; y++ {
-			R, G, B, A := img.At(x, y).RGBA()
-			i := (y*r.Dx() + x) * 4
-			data[i] = uint16(R)
-			data[i+1] = uint16(G)
-			data[i+2] = uint16(B)
-			data[i+3] = uint16(A)
-		}
-	}
-	C.glTexImage2D(TEXTURE_2D, 0, RGBA, C.GLsizei(img.Bounds().Dx()), C.GLsizei(img.Bounds().Dy()), C.GLint(border), RGBA, UNSIGNED_SHORT, unsafe.Pointer(&data[0]))
-	C.glTexParameteri(TEXTURE_2D, TEXTURE_MIN_FILTER, NEAREST)
-	C.glTexParameteri(TEXTURE_2D, TEXTURE_MAG_FILTER, NEAREST)
+	texImage(TEXTURE_2D, img, border, true)
+	C.glTexParameteri(C.GLenum(TEXTURE_2D), TEXTURE_MIN_FILTER, NEAREST)
+	C.glTexParameteri(C.GLenum(TEXTURE_2D), TEXTURE_MAG_FILTER, NEAREST)
 	tt.Unbind(TEXTURE_2D)
 	return tt
 }
 
-// Bind calls glBindTexture
-func (t Texture) Bind(targ int) {
-	C.glBindTexture(C.GLenum(targ), C.GLuint(t))
+// Bind calls glBindTexture unless the texture is already bound to targ of the active unit
+func (t Texture) Bind(targ TextureTarget) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.Bind", targ))
+	}
+	bindTexture(targ, C.GLuint(t))
 }
 
-// Unbind calls glBindTexture with a 0 argument
-func (Texture) Unbind(targ int) {
-	C.glBindTexture(C.GLenum(targ), 0)
+// Unbind calls glBindTexture with a 0 argument unless no texture is bound to targ of the active unit
+func (Texture) Unbind(targ TextureTarget) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.Unbind", targ))
+	}
+	bindTexture(targ, 0)
 }
 
 // TexParameteri calls glTexParameteri on the texture. The targ argument is used for binding and should most likely be TEXTURE_2D.
-func (t Texture) TexParameteri(targ, pname, param int) {
+func (t Texture) TexParameteri(targ TextureTarget, pname, param int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.TexParameteri", targ, pname, param))
+	}
 	t.Bind(targ)
 	C.glTexParameteri(C.GLenum(targ), C.GLenum(pname), C.GLint(param))
 	t.Unbind(targ)
 }
 
-// Enable calls glActiveTexture and Bind
-func (t Texture) Enable(unit int, targ int) {
-	C.glActiveTexture(TEXTURE0 + C.GLenum(unit))
+// Enable selects the texture unit with glActiveTexture and calls Bind; either call is skipped if it would not change the state
+func (t Texture) Enable(unit int, targ TextureTarget) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.Enable", unit, targ))
+	}
+	activeTexture(unit)
 	t.Bind(targ)
 }
 
-// Disable calls glActiveTexture and Unbind
-func (t Texture) Disable(unit int, targ int) {
-	C.glActiveTexture(TEXTURE0 + C.GLenum(unit))
+// Disable selects the texture unit with glActiveTexture and calls Unbind; either call is skipped if it would not change the state
+func (t Texture) Disable(unit int, targ TextureTarget) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.Disable", unit, targ))
+	}
+	activeTexture(unit)
 	t.Unbind(targ)
 }
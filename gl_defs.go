@@ -1,1328 +1,1376 @@
+// Code generated by glgen from gl.xml; DO NOT EDIT.
+
 package gl
 
 const (
-	GL_2_BYTES                                    = 0x1407
-	GL_2D                                         = 0x600
-	GL_3_BYTES                                    = 0x1408
-	GL_3D_COLOR_TEXTURE                           = 0x603
-	GL_3D_COLOR                                   = 0x602
-	GL_3D                                         = 0x601
-	GL_4_BYTES                                    = 0x1409
-	GL_4D_COLOR_TEXTURE                           = 0x604
-	ACCUM_ALPHA_BITS                              = 0xd5b
-	ACCUM_BLUE_BITS                               = 0xd5a
-	ACCUM_BUFFER_BIT                              = 0x200
-	ACCUM_CLEAR_VALUE                             = 0xb80
-	ACCUM_GREEN_BITS                              = 0xd59
-	ACCUM_RED_BITS                                = 0xd58
-	ACCUM                                         = 0x100
-	ACTIVE_ATTRIBUTE_MAX_LENGTH                   = 0x8b8a
-	ACTIVE_ATTRIBUTES                             = 0x8b89
-	ACTIVE_TEXTURE                                = 0x84e0
-	ACTIVE_UNIFORM_BLOCK_MAX_NAME_LENGTH          = 0x8a35
-	ACTIVE_UNIFORM_BLOCKS                         = 0x8a36
-	ACTIVE_UNIFORM_MAX_LENGTH                     = 0x8b87
-	ACTIVE_UNIFORMS                               = 0x8b86
-	ADD_SIGNED                                    = 0x8574
-	ADD                                           = 0x104
-	ALIASED_LINE_WIDTH_RANGE                      = 0x846e
-	ALIASED_POINT_SIZE_RANGE                      = 0x846d
-	ALL_ATTRIB_BITS                               = 0xfffff
-	ALPHA12                                       = 0x803d
-	ALPHA16_SNORM                                 = 0x9018
-	ALPHA16                                       = 0x803e
-	ALPHA4                                        = 0x803b
-	ALPHA8_SNORM                                  = 0x9014
-	ALPHA8                                        = 0x803c
-	ALPHA_BIAS                                    = 0xd1d
-	ALPHA_BITS                                    = 0xd55
-	ALPHA_INTEGER                                 = 0x8d97
-	ALPHA_SCALE                                   = 0xd1c
-	ALPHA_SNORM                                   = 0x9010
-	ALPHA_TEST_FUNC                               = 0xbc1
-	ALPHA_TEST_REF                                = 0xbc2
-	ALPHA_TEST                                    = 0xbc0
-	ALPHA                                         = 0x1906
-	ALREADY_SIGNALED                              = 0x911a
-	ALWAYS                                        = 0x207
-	AMBIENT_AND_DIFFUSE                           = 0x1602
-	AMBIENT                                       = 0x1200
-	AND_INVERTED                                  = 0x1504
-	AND_REVERSE                                   = 0x1502
-	AND                                           = 0x1501
-	ARRAY_BUFFER_BINDING                          = 0x8894
-	ARRAY_BUFFER                                  = 0x8892
-	ATTACHED_SHADERS                              = 0x8b85
-	ATTRIB_STACK_DEPTH                            = 0xbb0
-	AUTO_NORMAL                                   = 0xd80
-	AUX0                                          = 0x409
-	AUX1                                          = 0x40a
-	AUX2                                          = 0x40b
-	AUX3                                          = 0x40c
-	AUX_BUFFERS                                   = 0xc00
-	BACK_LEFT                                     = 0x402
-	BACK_RIGHT                                    = 0x403
-	BACK                                          = 0x405
-	BGRA_INTEGER                                  = 0x8d9b
-	BGRA                                          = 0x80e1
-	BGR_INTEGER                                   = 0x8d9a
-	BGR                                           = 0x80e0
-	BITMAP_TOKEN                                  = 0x704
-	BITMAP                                        = 0x1a00
-	BLEND_COLOR                                   = 0x8005
-	BLEND_DST_ALPHA                               = 0x80ca
-	BLEND_DST_RGB                                 = 0x80c8
-	BLEND_DST                                     = 0xbe0
-	BLEND_EQUATION_ALPHA                          = 0x883d
-	BLEND_EQUATION_RGB                            = 0x8009
-	BLEND_EQUATION                                = 0x8009
-	BLEND_SRC_ALPHA                               = 0x80cb
-	BLEND_SRC_RGB                                 = 0x80c9
-	BLEND_SRC                                     = 0xbe1
-	BLEND                                         = 0xbe2
-	BLUE_BIAS                                     = 0xd1b
-	BLUE_BITS                                     = 0xd54
-	BLUE_INTEGER                                  = 0x8d96
-	BLUE_SCALE                                    = 0xd1a
-	BLUE                                          = 0x1905
-	BOOL_VEC2                                     = 0x8b57
-	BOOL_VEC3                                     = 0x8b58
-	BOOL_VEC4                                     = 0x8b59
-	BOOL                                          = 0x8b56
-	BUFFER_ACCESS_FLAGS                           = 0x911f
-	BUFFER_ACCESS                                 = 0x88bb
-	BUFFER_MAP_LENGTH                             = 0x9120
-	BUFFER_MAP_OFFSET                             = 0x9121
-	BUFFER_MAPPED                                 = 0x88bc
-	BUFFER_MAP_POINTER                            = 0x88bd
-	BUFFER_SIZE                                   = 0x8764
-	BUFFER_USAGE                                  = 0x8765
-	BYTE                                          = 0x1400
-	C3F_V3F                                       = 0x2a24
-	C4F_N3F_V3F                                   = 0x2a26
-	C4UB_V2F                                      = 0x2a22
-	C4UB_V3F                                      = 0x2a23
-	CCW                                           = 0x901
-	CLAMP_FRAGMENT_COLOR                          = 0x891b
-	CLAMP_READ_COLOR                              = 0x891c
-	CLAMP_TO_BORDER                               = 0x812d
-	CLAMP_TO_EDGE                                 = 0x812f
-	CLAMP_VERTEX_COLOR                            = 0x891a
-	CLAMP                                         = 0x2900
-	CLEAR                                         = 0x1500
-	CLIENT_ACTIVE_TEXTURE                         = 0x84e1
-	CLIENT_ALL_ATTRIB_BITS                        = -0x1
-	CLIENT_ATTRIB_STACK_DEPTH                     = 0xbb1
-	CLIENT_PIXEL_STORE_BIT                        = 0x1
-	CLIENT_VERTEX_ARRAY_BIT                       = 0x2
-	CLIP_DISTANCE0                                = 0x3000
-	CLIP_DISTANCE1                                = 0x3001
-	CLIP_DISTANCE2                                = 0x3002
-	CLIP_DISTANCE3                                = 0x3003
-	CLIP_DISTANCE4                                = 0x3004
-	CLIP_DISTANCE5                                = 0x3005
-	CLIP_PLANE0                                   = 0x3000
-	CLIP_PLANE1                                   = 0x3001
-	CLIP_PLANE2                                   = 0x3002
-	CLIP_PLANE3                                   = 0x3003
-	CLIP_PLANE4                                   = 0x3004
-	CLIP_PLANE5                                   = 0x3005
-	COEFF                                         = 0xa00
-	COLOR_ARRAY_BUFFER_BINDING                    = 0x8898
-	COLOR_ARRAY_POINTER                           = 0x8090
-	COLOR_ARRAY_SIZE                              = 0x8081
-	COLOR_ARRAY_STRIDE                            = 0x8083
-	COLOR_ARRAY_TYPE                              = 0x8082
-	COLOR_ARRAY                                   = 0x8076
-	COLOR_ATTACHMENT0                             = 0x8ce0
-	COLOR_ATTACHMENT10                            = 0x8cea
-	COLOR_ATTACHMENT11                            = 0x8ceb
-	COLOR_ATTACHMENT12                            = 0x8cec
-	COLOR_ATTACHMENT13                            = 0x8ced
-	COLOR_ATTACHMENT14                            = 0x8cee
-	COLOR_ATTACHMENT15                            = 0x8cef
-	COLOR_ATTACHMENT1                             = 0x8ce1
-	COLOR_ATTACHMENT2                             = 0x8ce2
-	COLOR_ATTACHMENT3                             = 0x8ce3
-	COLOR_ATTACHMENT4                             = 0x8ce4
-	COLOR_ATTACHMENT5                             = 0x8ce5
-	COLOR_ATTACHMENT6                             = 0x8ce6
-	COLOR_ATTACHMENT7                             = 0x8ce7
-	COLOR_ATTACHMENT8                             = 0x8ce8
-	COLOR_ATTACHMENT9                             = 0x8ce9
-	COLOR_BUFFER_BIT                              = 0x4000
-	COLOR_CLEAR_VALUE                             = 0xc22
-	COLOR_INDEXES                                 = 0x1603
-	COLOR_INDEX                                   = 0x1900
-	COLOR_LOGIC_OP                                = 0xbf2
-	COLOR_MATERIAL_FACE                           = 0xb55
-	COLOR_MATERIAL_PARAMETER                      = 0xb56
-	COLOR_MATERIAL                                = 0xb57
-	COLOR_MATRIX_STACK_DEPTH                      = 0x80b2
-	COLOR_MATRIX                                  = 0x80b1
-	COLOR_SUM                                     = 0x8458
-	COLOR_TABLE_ALPHA_SIZE                        = 0x80dd
-	COLOR_TABLE_BIAS                              = 0x80d7
-	COLOR_TABLE_BLUE_SIZE                         = 0x80dc
-	COLOR_TABLE_FORMAT                            = 0x80d8
-	COLOR_TABLE_GREEN_SIZE                        = 0x80db
-	COLOR_TABLE_INTENSITY_SIZE                    = 0x80df
-	COLOR_TABLE_LUMINANCE_SIZE                    = 0x80de
-	COLOR_TABLE_RED_SIZE                          = 0x80da
-	COLOR_TABLE_SCALE                             = 0x80d6
-	COLOR_TABLE_WIDTH                             = 0x80d9
-	COLOR_TABLE                                   = 0x80d0
-	COLOR_WRITEMASK                               = 0xc23
-	COLOR                                         = 0x1800
-	COMBINE_ALPHA                                 = 0x8572
-	COMBINE_RGB                                   = 0x8571
-	COMBINE                                       = 0x8570
-	COMPARE_REF_TO_TEXTURE                        = 0x884e
-	COMPARE_R_TO_TEXTURE                          = 0x884e
-	COMPILE_AND_EXECUTE                           = 0x1301
-	COMPILE_STATUS                                = 0x8b81
-	COMPILE                                       = 0x1300
-	COMPRESSED_ALPHA                              = 0x84e9
-	COMPRESSED_INTENSITY                          = 0x84ec
-	COMPRESSED_LUMINANCE_ALPHA                    = 0x84eb
-	COMPRESSED_LUMINANCE                          = 0x84ea
-	COMPRESSED_RED_RGTC1                          = 0x8dbb
-	COMPRESSED_RED                                = 0x8225
-	COMPRESSED_RGBA                               = 0x84ee
-	COMPRESSED_RGB                                = 0x84ed
-	COMPRESSED_RG_RGTC2                           = 0x8dbd
-	COMPRESSED_RG                                 = 0x8226
-	COMPRESSED_SIGNED_RED_RGTC1                   = 0x8dbc
-	COMPRESSED_SIGNED_RG_RGTC2                    = 0x8dbe
-	COMPRESSED_SLUMINANCE_ALPHA                   = 0x8c4b
-	COMPRESSED_SLUMINANCE                         = 0x8c4a
-	COMPRESSED_SRGB_ALPHA                         = 0x8c49
-	COMPRESSED_SRGB                               = 0x8c48
-	COMPRESSED_TEXTURE_FORMATS                    = 0x86a3
-	CONDITION_SATISFIED                           = 0x911c
-	CONSTANT_ALPHA                                = 0x8003
-	CONSTANT_ATTENUATION                          = 0x1207
-	CONSTANT_BORDER                               = 0x8151
-	CONSTANT_COLOR                                = 0x8001
-	CONSTANT                                      = 0x8576
-	CONTEXT_COMPATIBILITY_PROFILE_BIT             = 0x2
-	CONTEXT_CORE_PROFILE_BIT                      = 0x1
-	CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT           = 0x1
-	CONTEXT_FLAGS                                 = 0x821e
-	CONTEXT_PROFILE_MASK                          = 0x9126
-	CONVOLUTION_1D                                = 0x8010
-	CONVOLUTION_2D                                = 0x8011
-	CONVOLUTION_BORDER_COLOR                      = 0x8154
-	CONVOLUTION_BORDER_MODE                       = 0x8013
-	CONVOLUTION_FILTER_BIAS                       = 0x8015
-	CONVOLUTION_FILTER_SCALE                      = 0x8014
-	CONVOLUTION_FORMAT                            = 0x8017
-	CONVOLUTION_HEIGHT                            = 0x8019
-	CONVOLUTION_WIDTH                             = 0x8018
-	COORD_REPLACE                                 = 0x8862
-	COPY_INVERTED                                 = 0x150c
-	COPY_PIXEL_TOKEN                              = 0x706
-	COPY_READ_BUFFER                              = 0x8f36
-	COPY_WRITE_BUFFER                             = 0x8f37
-	COPY                                          = 0x1503
-	CULL_FACE_MODE                                = 0xb45
-	CULL_FACE                                     = 0xb44
-	CURRENT_BIT                                   = 0x1
-	CURRENT_COLOR                                 = 0xb00
-	CURRENT_FOG_COORDINATE                        = 0x8453
-	CURRENT_FOG_COORD                             = 0x8453
-	CURRENT_INDEX                                 = 0xb01
-	CURRENT_NORMAL                                = 0xb02
-	CURRENT_PROGRAM                               = 0x8b8d
-	CURRENT_QUERY                                 = 0x8865
-	CURRENT_RASTER_COLOR                          = 0xb04
-	CURRENT_RASTER_DISTANCE                       = 0xb09
-	CURRENT_RASTER_INDEX                          = 0xb05
-	CURRENT_RASTER_POSITION_VALID                 = 0xb08
-	CURRENT_RASTER_POSITION                       = 0xb07
-	CURRENT_RASTER_SECONDARY_COLOR                = 0x845f
-	CURRENT_RASTER_TEXTURE_COORDS                 = 0xb06
-	CURRENT_SECONDARY_COLOR                       = 0x8459
-	CURRENT_TEXTURE_COORDS                        = 0xb03
-	CURRENT_VERTEX_ATTRIB                         = 0x8626
-	CW                                            = 0x900
-	DECAL                                         = 0x2101
-	DECR_WRAP                                     = 0x8508
-	DECR                                          = 0x1e03
-	DELETE_STATUS                                 = 0x8b80
-	DEPTH24_STENCIL8                              = 0x88f0
-	DEPTH32F_STENCIL8                             = 0x8cad
-	DEPTH_ATTACHMENT                              = 0x8d00
-	DEPTH_BIAS                                    = 0xd1f
-	DEPTH_BITS                                    = 0xd56
-	DEPTH_BUFFER_BIT                              = 0x100
-	DEPTH_BUFFER                                  = 0x8223
-	DEPTH_CLAMP                                   = 0x864f
-	DEPTH_CLEAR_VALUE                             = 0xb73
-	DEPTH_COMPONENT16                             = 0x81a5
-	DEPTH_COMPONENT24                             = 0x81a6
-	DEPTH_COMPONENT32F                            = 0x8cac
-	DEPTH_COMPONENT32                             = 0x81a7
-	DEPTH_COMPONENT                               = 0x1902
-	DEPTH_FUNC                                    = 0xb74
-	DEPTH_RANGE                                   = 0xb70
-	DEPTH_SCALE                                   = 0xd1e
-	DEPTH_STENCIL_ATTACHMENT                      = 0x821a
-	DEPTH_STENCIL                                 = 0x84f9
-	DEPTH_TEST                                    = 0xb71
-	DEPTH_TEXTURE_MODE                            = 0x884b
-	DEPTH_WRITEMASK                               = 0xb72
-	DEPTH                                         = 0x1801
-	DIFFUSE                                       = 0x1201
-	DITHER                                        = 0xbd0
-	DOMAIN                                        = 0xa02
-	DONT_CARE                                     = 0x1100
-	DOT3_RGBA                                     = 0x86af
-	DOT3_RGB                                      = 0x86ae
-	DOUBLEBUFFER                                  = 0xc32
-	DOUBLE                                        = 0x140a
-	DRAW_BUFFER0                                  = 0x8825
-	DRAW_BUFFER10                                 = 0x882f
-	DRAW_BUFFER11                                 = 0x8830
-	DRAW_BUFFER12                                 = 0x8831
-	DRAW_BUFFER13                                 = 0x8832
-	DRAW_BUFFER14                                 = 0x8833
-	DRAW_BUFFER15                                 = 0x8834
-	DRAW_BUFFER1                                  = 0x8826
-	DRAW_BUFFER2                                  = 0x8827
-	DRAW_BUFFER3                                  = 0x8828
-	DRAW_BUFFER4                                  = 0x8829
-	DRAW_BUFFER5                                  = 0x882a
-	DRAW_BUFFER6                                  = 0x882b
-	DRAW_BUFFER7                                  = 0x882c
-	DRAW_BUFFER8                                  = 0x882d
-	DRAW_BUFFER9                                  = 0x882e
-	DRAW_BUFFER                                   = 0xc01
-	DRAW_FRAMEBUFFER_BINDING                      = 0x8ca6
-	DRAW_FRAMEBUFFER                              = 0x8ca9
-	DRAW_PIXEL_TOKEN                              = 0x705
-	DST_ALPHA                                     = 0x304
-	DST_COLOR                                     = 0x306
-	DYNAMIC_COPY                                  = 0x88ea
-	DYNAMIC_DRAW                                  = 0x88e8
-	DYNAMIC_READ                                  = 0x88e9
-	EDGE_FLAG_ARRAY_BUFFER_BINDING                = 0x889b
-	EDGE_FLAG_ARRAY_POINTER                       = 0x8093
-	EDGE_FLAG_ARRAY_STRIDE                        = 0x808c
-	EDGE_FLAG_ARRAY                               = 0x8079
-	EDGE_FLAG                                     = 0xb43
-	ELEMENT_ARRAY_BUFFER_BINDING                  = 0x8895
-	ELEMENT_ARRAY_BUFFER                          = 0x8893
-	EMISSION                                      = 0x1600
-	ENABLE_BIT                                    = 0x2000
-	EQUAL                                         = 0x202
-	EQUIV                                         = 0x1509
-	EVAL_BIT                                      = 0x10000
-	EXP2                                          = 0x801
-	EXP                                           = 0x800
-	EXTENSIONS                                    = 0x1f03
-	EYE_LINEAR                                    = 0x2400
-	EYE_PLANE                                     = 0x2502
-	FALSE                                         = 0
-	FASTEST                                       = 0x1101
-	FEEDBACK_BUFFER_POINTER                       = 0xdf0
-	FEEDBACK_BUFFER_SIZE                          = 0xdf1
-	FEEDBACK_BUFFER_TYPE                          = 0xdf2
-	FEEDBACK                                      = 0x1c01
-	FILL                                          = 0x1b02
-	FIRST_VERTEX_CONVENTION                       = 0x8e4d
-	FIXED_ONLY                                    = 0x891d
-	FLAT                                          = 0x1d00
-	FLOAT_32_UNSIGNED_INT_24_8_REV                = 0x8dad
-	FLOAT_MAT2x3                                  = 0x8b65
-	FLOAT_MAT2x4                                  = 0x8b66
-	FLOAT_MAT2                                    = 0x8b5a
-	FLOAT_MAT3x2                                  = 0x8b67
-	FLOAT_MAT3x4                                  = 0x8b68
-	FLOAT_MAT3                                    = 0x8b5b
-	FLOAT_MAT4x2                                  = 0x8b69
-	FLOAT_MAT4x3                                  = 0x8b6a
-	FLOAT_MAT4                                    = 0x8b5c
-	FLOAT_VEC2                                    = 0x8b50
-	FLOAT_VEC3                                    = 0x8b51
-	FLOAT_VEC4                                    = 0x8b52
-	FLOAT                                         = 0x1406
-	FOG_BIT                                       = 0x80
-	FOG_COLOR                                     = 0xb66
-	FOG_COORD_ARRAY_BUFFER_BINDING                = 0x889d
-	FOG_COORD_ARRAY_POINTER                       = 0x8456
-	FOG_COORD_ARRAY_STRIDE                        = 0x8455
-	FOG_COORD_ARRAY_TYPE                          = 0x8454
-	FOG_COORD_ARRAY                               = 0x8457
-	FOG_COORDINATE_ARRAY_BUFFER_BINDING           = 0x889d
-	FOG_COORDINATE_ARRAY_POINTER                  = 0x8456
-	FOG_COORDINATE_ARRAY_STRIDE                   = 0x8455
-	FOG_COORDINATE_ARRAY_TYPE                     = 0x8454
-	FOG_COORDINATE_ARRAY                          = 0x8457
-	FOG_COORDINATE_SOURCE                         = 0x8450
-	FOG_COORDINATE                                = 0x8451
-	FOG_COORD_SRC                                 = 0x8450
-	FOG_COORD                                     = 0x8451
-	FOG_DENSITY                                   = 0xb62
-	FOG_END                                       = 0xb64
-	FOG_HINT                                      = 0xc54
-	FOG_INDEX                                     = 0xb61
-	FOG_MODE                                      = 0xb65
-	FOG_START                                     = 0xb63
-	FOG                                           = 0xb60
-	FRAGMENT_DEPTH                                = 0x8452
-	FRAGMENT_SHADER_DERIVATIVE_HINT               = 0x8b8b
-	FRAGMENT_SHADER                               = 0x8b30
-	FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE             = 0x8215
-	FRAMEBUFFER_ATTACHMENT_BLUE_SIZE              = 0x8214
-	FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING         = 0x8210
-	FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE         = 0x8211
-	FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE             = 0x8216
-	FRAMEBUFFER_ATTACHMENT_GREEN_SIZE             = 0x8213
-	FRAMEBUFFER_ATTACHMENT_LAYERED                = 0x8da7
-	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME            = 0x8cd1
-	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE            = 0x8cd0
-	FRAMEBUFFER_ATTACHMENT_RED_SIZE               = 0x8212
-	FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE           = 0x8217
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE  = 0x8cd3
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER          = 0x8cd4
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL          = 0x8cd2
-	FRAMEBUFFER_BINDING                           = 0x8ca6
-	FRAMEBUFFER_COMPLETE                          = 0x8cd5
-	FRAMEBUFFER_DEFAULT                           = 0x8218
-	FRAMEBUFFER_INCOMPLETE_ATTACHMENT             = 0x8cd6
-	FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER            = 0x8cdb
-	FRAMEBUFFER_INCOMPLETE_LAYER_TARGETS          = 0x8da8
-	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT     = 0x8cd7
-	FRAMEBUFFER_INCOMPLETE_MULTISAMPLE            = 0x8d56
-	FRAMEBUFFER_INCOMPLETE_READ_BUFFER            = 0x8cdc
-	FRAMEBUFFER_SRGB                              = 0x8db9
-	FRAMEBUFFER_UNDEFINED                         = 0x8219
-	FRAMEBUFFER_UNSUPPORTED                       = 0x8cdd
-	FRAMEBUFFER                                   = 0x8d40
-	FRONT_AND_BACK                                = 0x408
-	FRONT_FACE                                    = 0xb46
-	FRONT_LEFT                                    = 0x400
-	FRONT_RIGHT                                   = 0x401
-	FRONT                                         = 0x404
-	FUNC_ADD                                      = 0x8006
-	FUNC_REVERSE_SUBTRACT                         = 0x800b
-	FUNC_SUBTRACT                                 = 0x800a
-	GENERATE_MIPMAP_HINT                          = 0x8192
-	GENERATE_MIPMAP                               = 0x8191
-	GEOMETRY_INPUT_TYPE                           = 0x8917
-	GEOMETRY_OUTPUT_TYPE                          = 0x8918
-	GEOMETRY_SHADER                               = 0x8dd9
-	GEOMETRY_VERTICES_OUT                         = 0x8916
-	GEQUAL                                        = 0x206
-	GREATER                                       = 0x204
-	GREEN_BIAS                                    = 0xd19
-	GREEN_BITS                                    = 0xd53
-	GREEN_INTEGER                                 = 0x8d95
-	GREEN_SCALE                                   = 0xd18
-	GREEN                                         = 0x1904
-	HALF_FLOAT                                    = 0x140b
-	HINT_BIT                                      = 0x8000
-	HISTOGRAM_ALPHA_SIZE                          = 0x802b
-	HISTOGRAM_BLUE_SIZE                           = 0x802a
-	HISTOGRAM_FORMAT                              = 0x8027
-	HISTOGRAM_GREEN_SIZE                          = 0x8029
-	HISTOGRAM_LUMINANCE_SIZE                      = 0x802c
-	HISTOGRAM_RED_SIZE                            = 0x8028
-	HISTOGRAM_SINK                                = 0x802d
-	HISTOGRAM_WIDTH                               = 0x8026
-	HISTOGRAM                                     = 0x8024
-	INCR_WRAP                                     = 0x8507
-	INCR                                          = 0x1e02
-	INDEX_ARRAY_BUFFER_BINDING                    = 0x8899
-	INDEX_ARRAY_POINTER                           = 0x8091
-	INDEX_ARRAY_STRIDE                            = 0x8086
-	INDEX_ARRAY_TYPE                              = 0x8085
-	INDEX_ARRAY                                   = 0x8077
-	INDEX_BITS                                    = 0xd51
-	INDEX_CLEAR_VALUE                             = 0xc20
-	INDEX_LOGIC_OP                                = 0xbf1
-	INDEX_MODE                                    = 0xc30
-	INDEX_OFFSET                                  = 0xd13
-	INDEX_SHIFT                                   = 0xd12
-	INDEX_WRITEMASK                               = 0xc21
-	INDEX                                         = 0x8222
-	INFO_LOG_LENGTH                               = 0x8b84
-	INTENSITY12                                   = 0x804c
-	INTENSITY16_SNORM                             = 0x901b
-	INTENSITY16                                   = 0x804d
-	INTENSITY4                                    = 0x804a
-	INTENSITY8_SNORM                              = 0x9017
-	INTENSITY8                                    = 0x804b
-	INTENSITY_SNORM                               = 0x9013
-	INTENSITY                                     = 0x8049
-	INTERLEAVED_ATTRIBS                           = 0x8c8c
-	INTERPOLATE                                   = 0x8575
-	INT_SAMPLER_1D_ARRAY                          = 0x8dce
-	INT_SAMPLER_1D                                = 0x8dc9
-	INT_SAMPLER_2D_ARRAY                          = 0x8dcf
-	INT_SAMPLER_2D_MULTISAMPLE_ARRAY              = 0x910c
-	INT_SAMPLER_2D_MULTISAMPLE                    = 0x9109
-	INT_SAMPLER_2D_RECT                           = 0x8dcd
-	INT_SAMPLER_2D                                = 0x8dca
-	INT_SAMPLER_3D                                = 0x8dcb
-	INT_SAMPLER_BUFFER                            = 0x8dd0
-	INT_SAMPLER_CUBE_MAP_ARRAY                    = 0x900e
-	INT_SAMPLER_CUBE                              = 0x8dcc
-	INT_VEC2                                      = 0x8b53
-	INT_VEC3                                      = 0x8b54
-	INT_VEC4                                      = 0x8b55
-	INT                                           = 0x1404
-	INVALID_ENUM                                  = 0x500
-	INVALID_FRAMEBUFFER_OPERATION                 = 0x506
-	INVALID_INDEX                                 = -0x1
-	INVALID_OPERATION                             = 0x502
-	INVALID_VALUE                                 = 0x501
-	INVERTED_SCREEN_W_REND                        = 0x8491
-	INVERT                                        = 0x150a
-	KEEP                                          = 0x1e00
-	LAST_VERTEX_CONVENTION                        = 0x8e4e
-	LEFT                                          = 0x406
-	LEQUAL                                        = 0x203
-	LESS                                          = 0x201
-	LIGHT0                                        = 0x4000
-	LIGHT1                                        = 0x4001
-	LIGHT2                                        = 0x4002
-	LIGHT3                                        = 0x4003
-	LIGHT4                                        = 0x4004
-	LIGHT5                                        = 0x4005
-	LIGHT6                                        = 0x4006
-	LIGHT7                                        = 0x4007
-	LIGHTING_BIT                                  = 0x40
-	LIGHTING                                      = 0xb50
-	LIGHT_MODEL_AMBIENT                           = 0xb53
-	LIGHT_MODEL_COLOR_CONTROL                     = 0x81f8
-	LIGHT_MODEL_LOCAL_VIEWER                      = 0xb51
-	LIGHT_MODEL_TWO_SIDE                          = 0xb52
-	LINEAR_ATTENUATION                            = 0x1208
-	LINEAR_MIPMAP_LINEAR                          = 0x2703
-	LINEAR_MIPMAP_NEAREST                         = 0x2701
-	LINEAR                                        = 0x2601
-	LINE_BIT                                      = 0x4
-	LINE_LOOP                                     = 0x2
-	LINE_RESET_TOKEN                              = 0x707
-	LINES_ADJACENCY                               = 0xa
-	LINE_SMOOTH_HINT                              = 0xc52
-	LINE_SMOOTH                                   = 0xb20
-	LINE_STIPPLE_PATTERN                          = 0xb25
-	LINE_STIPPLE_REPEAT                           = 0xb26
-	LINE_STIPPLE                                  = 0xb24
-	LINE_STRIP_ADJACENCY                          = 0xb
-	LINE_STRIP                                    = 0x3
-	LINES                                         = 0x1
-	LINE_TOKEN                                    = 0x702
-	LINE_WIDTH_GRANULARITY                        = 0xb23
-	LINE_WIDTH_RANGE                              = 0xb22
-	LINE_WIDTH                                    = 0xb21
-	LINE                                          = 0x1b01
-	LINK_STATUS                                   = 0x8b82
-	LIST_BASE                                     = 0xb32
-	LIST_BIT                                      = 0x20000
-	LIST_INDEX                                    = 0xb33
-	LIST_MODE                                     = 0xb30
-	LOAD                                          = 0x101
-	LOGIC_OP_MODE                                 = 0xbf0
-	LOGIC_OP                                      = 0xbf1
-	LOWER_LEFT                                    = 0x8ca1
-	LUMINANCE12_ALPHA12                           = 0x8047
-	LUMINANCE12_ALPHA4                            = 0x8046
-	LUMINANCE12                                   = 0x8041
-	LUMINANCE16_ALPHA16_SNORM                     = 0x901a
-	LUMINANCE16_ALPHA16                           = 0x8048
-	LUMINANCE16_SNORM                             = 0x9019
-	LUMINANCE16                                   = 0x8042
-	LUMINANCE4_ALPHA4                             = 0x8043
-	LUMINANCE4                                    = 0x803f
-	LUMINANCE6_ALPHA2                             = 0x8044
-	LUMINANCE8_ALPHA8_SNORM                       = 0x9016
-	LUMINANCE8_ALPHA8                             = 0x8045
-	LUMINANCE8_SNORM                              = 0x9015
-	LUMINANCE8                                    = 0x8040
-	LUMINANCE_ALPHA_SNORM                         = 0x9012
-	LUMINANCE_ALPHA                               = 0x190a
-	LUMINANCE_SNORM                               = 0x9011
-	LUMINANCE                                     = 0x1909
-	MAJOR_VERSION                                 = 0x821b
-	MAP1_COLOR_4                                  = 0xd90
-	MAP1_GRID_DOMAIN                              = 0xdd0
-	MAP1_GRID_SEGMENTS                            = 0xdd1
-	MAP1_INDEX                                    = 0xd91
-	MAP1_NORMAL                                   = 0xd92
-	MAP1_TEXTURE_COORD_1                          = 0xd93
-	MAP1_TEXTURE_COORD_2                          = 0xd94
-	MAP1_TEXTURE_COORD_3                          = 0xd95
-	MAP1_TEXTURE_COORD_4                          = 0xd96
-	MAP1_VERTEX_3                                 = 0xd97
-	MAP1_VERTEX_4                                 = 0xd98
-	MAP2_COLOR_4                                  = 0xdb0
-	MAP2_GRID_DOMAIN                              = 0xdd2
-	MAP2_GRID_SEGMENTS                            = 0xdd3
-	MAP2_INDEX                                    = 0xdb1
-	MAP2_NORMAL                                   = 0xdb2
-	MAP2_TEXTURE_COORD_1                          = 0xdb3
-	MAP2_TEXTURE_COORD_2                          = 0xdb4
-	MAP2_TEXTURE_COORD_3                          = 0xdb5
-	MAP2_TEXTURE_COORD_4                          = 0xdb6
-	MAP2_VERTEX_3                                 = 0xdb7
-	MAP2_VERTEX_4                                 = 0xdb8
-	MAP_COLOR                                     = 0xd10
-	MAP_FLUSH_EXPLICIT_BIT                        = 0x10
-	MAP_INVALIDATE_BUFFER_BIT                     = 0x8
-	MAP_INVALIDATE_RANGE_BIT                      = 0x4
-	MAP_READ_BIT                                  = 0x1
-	MAP_STENCIL                                   = 0xd11
-	MAP_UNSYNCHRONIZED_BIT                        = 0x20
-	MAP_WRITE_BIT                                 = 0x2
-	MATRIX_MODE                                   = 0xba0
-	MAX_3D_TEXTURE_SIZE                           = 0x8073
-	MAX_ARRAY_TEXTURE_LAYERS                      = 0x88ff
-	MAX_ATTRIB_STACK_DEPTH                        = 0xd35
-	MAX_CLIENT_ATTRIB_STACK_DEPTH                 = 0xd3b
-	MAX_CLIP_DISTANCES                            = 0xd32
-	MAX_CLIP_PLANES                               = 0xd32
-	MAX_COLOR_ATTACHMENTS                         = 0x8cdf
-	MAX_COLOR_MATRIX_STACK_DEPTH                  = 0x80b3
-	MAX_COLOR_TEXTURE_SAMPLES                     = 0x910e
-	MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS      = 0x8a33
-	MAX_COMBINED_GEOMETRY_UNIFORM_COMPONENTS      = 0x8a32
-	MAX_COMBINED_TEXTURE_IMAGE_UNITS              = 0x8b4d
-	MAX_COMBINED_UNIFORM_BLOCKS                   = 0x8a2e
-	MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS        = 0x8a31
-	MAX_CONVOLUTION_HEIGHT                        = 0x801b
-	MAX_CONVOLUTION_WIDTH                         = 0x801a
-	MAX_CUBE_MAP_TEXTURE_SIZE                     = 0x851c
-	MAX_DEPTH_TEXTURE_SAMPLES                     = 0x910f
-	MAX_DRAW_BUFFERS                              = 0x8824
-	MAX_ELEMENTS_INDICES                          = 0x80e9
-	MAX_ELEMENTS_VERTICES                         = 0x80e8
-	MAX_EVAL_ORDER                                = 0xd30
-	MAX_FRAGMENT_INPUT_COMPONENTS                 = 0x9125
-	MAX_FRAGMENT_UNIFORM_BLOCKS                   = 0x8a2d
-	MAX_FRAGMENT_UNIFORM_COMPONENTS               = 0x8b49
-	MAX_GEOMETRY_INPUT_COMPONENTS                 = 0x9123
-	MAX_GEOMETRY_OUTPUT_COMPONENTS                = 0x9124
-	MAX_GEOMETRY_OUTPUT_VERTICES                  = 0x8de0
-	MAX_GEOMETRY_TEXTURE_IMAGE_UNITS              = 0x8c29
-	MAX_GEOMETRY_TOTAL_OUTPUT_COMPONENTS          = 0x8de1
-	MAX_GEOMETRY_UNIFORM_BLOCKS                   = 0x8a2c
-	MAX_GEOMETRY_UNIFORM_COMPONENTS               = 0x8ddf
-	MAX_INTEGER_SAMPLES                           = 0x9110
-	MAX_LIGHTS                                    = 0xd31
-	MAX_LIST_NESTING                              = 0xb31
-	MAX_MODELVIEW_STACK_DEPTH                     = 0xd36
-	MAX_NAME_STACK_DEPTH                          = 0xd37
-	MAX_PIXEL_MAP_TABLE                           = 0xd34
-	MAX_PROGRAM_TEXEL_OFFSET                      = 0x8905
-	MAX_PROGRAM_TEXTURE_GATHER_COMPONENTS         = 0x8f9f
-	MAX_PROGRAM_TEXTURE_GATHER_OFFSET             = 0x8e5f
-	MAX_PROJECTION_STACK_DEPTH                    = 0xd38
-	MAX_RECTANGLE_TEXTURE_SIZE                    = 0x84f8
-	MAX_RENDERBUFFER_SIZE                         = 0x84e8
-	MAX_SAMPLE_MASK_WORDS                         = 0x8e59
-	MAX_SAMPLES                                   = 0x8d57
-	MAX_SERVER_WAIT_TIMEOUT                       = 0x9111
-	MAX_TEXTURE_BUFFER_SIZE                       = 0x8c2b
-	MAX_TEXTURE_COORDS                            = 0x8871
-	MAX_TEXTURE_IMAGE_UNITS                       = 0x8872
-	MAX_TEXTURE_LOD_BIAS                          = 0x84fd
-	MAX_TEXTURE_SIZE                              = 0xd33
-	MAX_TEXTURE_STACK_DEPTH                       = 0xd39
-	MAX_TEXTURE_UNITS                             = 0x84e2
-	MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS = 0x8c8a
-	MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS       = 0x8c8b
-	MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS    = 0x8c80
-	MAX_UNIFORM_BLOCK_SIZE                        = 0x8a30
-	MAX_UNIFORM_BUFFER_BINDINGS                   = 0x8a2f
-	MAX_VARYING_COMPONENTS                        = 0x8b4b
-	MAX_VARYING_FLOATS                            = 0x8b4b
-	MAX_VERTEX_ATTRIBS                            = 0x8869
-	MAX_VERTEX_OUTPUT_COMPONENTS                  = 0x9122
-	MAX_VERTEX_TEXTURE_IMAGE_UNITS                = 0x8b4c
-	MAX_VERTEX_UNIFORM_BLOCKS                     = 0x8a2b
-	MAX_VERTEX_UNIFORM_COMPONENTS                 = 0x8b4a
-	MAX_VIEWPORT_DIMS                             = 0xd3a
-	MAX                                           = 0x8008
-	MINMAX_FORMAT                                 = 0x802f
-	MINMAX_SINK                                   = 0x8030
-	MINMAX                                        = 0x802e
-	MINOR_VERSION                                 = 0x821c
-	MIN_PROGRAM_TEXEL_OFFSET                      = 0x8904
-	MIN_PROGRAM_TEXTURE_GATHER_OFFSET             = 0x8e5e
-	MIN_SAMPLE_SHADING_VALUE                      = 0x8c37
-	MIN                                           = 0x8007
-	MIRRORED_REPEAT                               = 0x8370
-	MODELVIEW_MATRIX                              = 0xba6
-	MODELVIEW_STACK_DEPTH                         = 0xba3
-	MODELVIEW                                     = 0x1700
-	MODULATE                                      = 0x2100
-	MULTISAMPLE_BIT                               = 0x20000000
-	MULTISAMPLE                                   = 0x809d
-	MULT                                          = 0x103
-	N3F_V3F                                       = 0x2a25
-	NAME_STACK_DEPTH                              = 0xd70
-	NAND                                          = 0x150e
-	NEAREST_MIPMAP_LINEAR                         = 0x2702
-	NEAREST_MIPMAP_NEAREST                        = 0x2700
-	NEAREST                                       = 0x2600
-	NEVER                                         = 0x200
-	NICEST                                        = 0x1102
-	NO_ERROR                                      = 0
-	NONE                                          = 0
-	NOOP                                          = 0x1505
-	NORMAL_ARRAY_BUFFER_BINDING                   = 0x8897
-	NORMAL_ARRAY_POINTER                          = 0x808f
-	NORMAL_ARRAY_STRIDE                           = 0x807f
-	NORMAL_ARRAY_TYPE                             = 0x807e
-	NORMAL_ARRAY                                  = 0x8075
-	NORMALIZE                                     = 0xba1
-	NORMAL_MAP                                    = 0x8511
-	NOR                                           = 0x1508
-	NOTEQUAL                                      = 0x205
-	NUM_COMPRESSED_TEXTURE_FORMATS                = 0x86a2
-	OBJECT_LINEAR                                 = 0x2401
-	OBJECT_PLANE                                  = 0x2501
-	OBJECT_TYPE                                   = 0x9112
-	ONE_MINUS_CONSTANT_ALPHA                      = 0x8004
-	ONE_MINUS_CONSTANT_COLOR                      = 0x8002
-	ONE_MINUS_DST_ALPHA                           = 0x305
-	ONE_MINUS_DST_COLOR                           = 0x307
-	ONE_MINUS_SRC_ALPHA                           = 0x303
-	ONE_MINUS_SRC_COLOR                           = 0x301
-	ONE                                           = 0x1
-	OPERAND0_ALPHA                                = 0x8598
-	OPERAND0_RGB                                  = 0x8590
-	OPERAND1_ALPHA                                = 0x8599
-	OPERAND1_RGB                                  = 0x8591
-	OPERAND2_ALPHA                                = 0x859a
-	OPERAND2_RGB                                  = 0x8592
-	ORDER                                         = 0xa01
-	OR_INVERTED                                   = 0x150d
-	OR_REVERSE                                    = 0x150b
-	OR                                            = 0x1507
-	OUT_OF_MEMORY                                 = 0x505
-	PACK_ALIGNMENT                                = 0xd05
-	PACK_IMAGE_HEIGHT                             = 0x806c
-	PACK_LSB_FIRST                                = 0xd01
-	PACK_ROW_LENGTH                               = 0xd02
-	PACK_SKIP_IMAGES                              = 0x806b
-	PACK_SKIP_PIXELS                              = 0xd04
-	PACK_SKIP_ROWS                                = 0xd03
-	PACK_SWAP_BYTES                               = 0xd00
-	PASS_THROUGH_TOKEN                            = 0x700
-	PERSPECTIVE_CORRECTION_HINT                   = 0xc50
-	PIXEL_MAP_A_TO_A_SIZE                         = 0xcb9
-	PIXEL_MAP_A_TO_A                              = 0xc79
-	PIXEL_MAP_B_TO_B_SIZE                         = 0xcb8
-	PIXEL_MAP_B_TO_B                              = 0xc78
-	PIXEL_MAP_G_TO_G_SIZE                         = 0xcb7
-	PIXEL_MAP_G_TO_G                              = 0xc77
-	PIXEL_MAP_I_TO_A_SIZE                         = 0xcb5
-	PIXEL_MAP_I_TO_A                              = 0xc75
-	PIXEL_MAP_I_TO_B_SIZE                         = 0xcb4
-	PIXEL_MAP_I_TO_B                              = 0xc74
-	PIXEL_MAP_I_TO_G_SIZE                         = 0xcb3
-	PIXEL_MAP_I_TO_G                              = 0xc73
-	PIXEL_MAP_I_TO_I_SIZE                         = 0xcb0
-	PIXEL_MAP_I_TO_I                              = 0xc70
-	PIXEL_MAP_I_TO_R_SIZE                         = 0xcb2
-	PIXEL_MAP_I_TO_R                              = 0xc72
-	PIXEL_MAP_R_TO_R_SIZE                         = 0xcb6
-	PIXEL_MAP_R_TO_R                              = 0xc76
-	PIXEL_MAP_S_TO_S_SIZE                         = 0xcb1
-	PIXEL_MAP_S_TO_S                              = 0xc71
-	PIXEL_MODE_BIT                                = 0x20
-	PIXEL_PACK_BUFFER_BINDING                     = 0x88ed
-	PIXEL_PACK_BUFFER                             = 0x88eb
-	PIXEL_UNPACK_BUFFER_BINDING                   = 0x88ef
-	PIXEL_UNPACK_BUFFER                           = 0x88ec
-	POINT_BIT                                     = 0x2
-	POINT_DISTANCE_ATTENUATION                    = 0x8129
-	POINT_FADE_THRESHOLD_SIZE                     = 0x8128
-	POINT_SIZE_GRANULARITY                        = 0xb13
-	POINT_SIZE_MAX                                = 0x8127
-	POINT_SIZE_MIN                                = 0x8126
-	POINT_SIZE_RANGE                              = 0xb12
-	POINT_SIZE                                    = 0xb11
-	POINT_SMOOTH_HINT                             = 0xc51
-	POINT_SMOOTH                                  = 0xb10
-	POINT_SPRITE_COORD_ORIGIN                     = 0x8ca0
-	POINT_SPRITE                                  = 0x8861
-	POINTS                                        = 0
-	POINT_TOKEN                                   = 0x701
-	POINT                                         = 0x1b00
-	POLYGON_BIT                                   = 0x8
-	POLYGON_MODE                                  = 0xb40
-	POLYGON_OFFSET_FACTOR                         = 0x8038
-	POLYGON_OFFSET_FILL                           = 0x8037
-	POLYGON_OFFSET_LINE                           = 0x2a02
-	POLYGON_OFFSET_POINT                          = 0x2a01
-	POLYGON_OFFSET_UNITS                          = 0x2a00
-	POLYGON_SMOOTH_HINT                           = 0xc53
-	POLYGON_SMOOTH                                = 0xb41
-	POLYGON_STIPPLE_BIT                           = 0x10
-	POLYGON_STIPPLE                               = 0xb42
-	POLYGON_TOKEN                                 = 0x703
-	POLYGON                                       = 0x9
-	POSITION                                      = 0x1203
-	POST_COLOR_MATRIX_ALPHA_BIAS                  = 0x80bb
-	POST_COLOR_MATRIX_ALPHA_SCALE                 = 0x80b7
-	POST_COLOR_MATRIX_BLUE_BIAS                   = 0x80ba
-	POST_COLOR_MATRIX_BLUE_SCALE                  = 0x80b6
-	POST_COLOR_MATRIX_COLOR_TABLE                 = 0x80d2
-	POST_COLOR_MATRIX_GREEN_BIAS                  = 0x80b9
-	POST_COLOR_MATRIX_GREEN_SCALE                 = 0x80b5
-	POST_COLOR_MATRIX_RED_BIAS                    = 0x80b8
-	POST_COLOR_MATRIX_RED_SCALE                   = 0x80b4
-	POST_CONVOLUTION_ALPHA_BIAS                   = 0x8023
-	POST_CONVOLUTION_ALPHA_SCALE                  = 0x801f
-	POST_CONVOLUTION_BLUE_BIAS                    = 0x8022
-	POST_CONVOLUTION_BLUE_SCALE                   = 0x801e
-	POST_CONVOLUTION_COLOR_TABLE                  = 0x80d1
-	POST_CONVOLUTION_GREEN_BIAS                   = 0x8021
-	POST_CONVOLUTION_GREEN_SCALE                  = 0x801d
-	POST_CONVOLUTION_RED_BIAS                     = 0x8020
-	POST_CONVOLUTION_RED_SCALE                    = 0x801c
-	PREVIOUS                                      = 0x8578
-	PRIMARY_COLOR                                 = 0x8577
-	PRIMITIVE_RESTART_INDEX                       = 0x8f9e
-	PRIMITIVE_RESTART                             = 0x8f9d
-	PRIMITIVES_GENERATED                          = 0x8c87
-	PROGRAM_POINT_SIZE                            = 0x8642
-	PROJECTION_MATRIX                             = 0xba7
-	PROJECTION_STACK_DEPTH                        = 0xba4
-	PROJECTION                                    = 0x1701
-	PROVOKING_VERTEX                              = 0x8e4f
-	PROXY_COLOR_TABLE                             = 0x80d3
-	PROXY_HISTOGRAM                               = 0x8025
-	PROXY_POST_COLOR_MATRIX_COLOR_TABLE           = 0x80d5
-	PROXY_POST_CONVOLUTION_COLOR_TABLE            = 0x80d4
-	PROXY_TEXTURE_1D_ARRAY                        = 0x8c19
-	PROXY_TEXTURE_1D                              = 0x8063
-	PROXY_TEXTURE_2D_ARRAY                        = 0x8c1b
-	PROXY_TEXTURE_2D_MULTISAMPLE_ARRAY            = 0x9103
-	PROXY_TEXTURE_2D_MULTISAMPLE                  = 0x9101
-	PROXY_TEXTURE_2D                              = 0x8064
-	PROXY_TEXTURE_3D                              = 0x8070
-	PROXY_TEXTURE_CUBE_MAP_ARRAY                  = 0x900b
-	PROXY_TEXTURE_CUBE_MAP                        = 0x851b
-	PROXY_TEXTURE_RECTANGLE                       = 0x84f7
-	QUADRATIC_ATTENUATION                         = 0x1209
-	QUADS_FOLLOW_PROVOKING_VERTEX_CONVENTION      = 0x8e4c
-	QUAD_STRIP                                    = 0x8
-	QUADS                                         = 0x7
-	QUERY_BY_REGION_NO_WAIT                       = 0x8e16
-	QUERY_BY_REGION_WAIT                          = 0x8e15
-	QUERY_COUNTER_BITS                            = 0x8864
-	QUERY_NO_WAIT                                 = 0x8e14
-	QUERY_RESULT_AVAILABLE                        = 0x8867
-	QUERY_RESULT                                  = 0x8866
-	QUERY_WAIT                                    = 0x8e13
-	Q                                             = 0x2003
-	R11F_G11F_B10F                                = 0x8c3a
-	R16F                                          = 0x822d
-	R16I                                          = 0x8233
-	R16_SNORM                                     = 0x8f98
-	R16UI                                         = 0x8234
-	R16                                           = 0x822a
-	R32F                                          = 0x822e
-	R32I                                          = 0x8235
-	R32UI                                         = 0x8236
-	R3_G3_B2                                      = 0x2a10
-	R8I                                           = 0x8231
-	R8_SNORM                                      = 0x8f94
-	R8UI                                          = 0x8232
-	R8                                            = 0x8229
-	RASTERIZER_DISCARD                            = 0x8c89
-	READ_BUFFER                                   = 0xc02
-	READ_FRAMEBUFFER_BINDING                      = 0x8caa
-	READ_FRAMEBUFFER                              = 0x8ca8
-	READ_ONLY                                     = 0x88b8
-	READ_WRITE                                    = 0x88ba
-	RED_BIAS                                      = 0xd15
-	RED_BITS                                      = 0xd52
-	RED_INTEGER                                   = 0x8d94
-	RED_SCALE                                     = 0xd14
-	RED_SNORM                                     = 0x8f90
-	REDUCE                                        = 0x8016
-	RED                                           = 0x1903
-	REFLECTION_MAP                                = 0x8512
-	RENDERBUFFER_ALPHA_SIZE                       = 0x8d53
-	RENDERBUFFER_BINDING                          = 0x8ca7
-	RENDERBUFFER_BLUE_SIZE                        = 0x8d52
-	RENDERBUFFER_DEPTH_SIZE                       = 0x8d54
-	RENDERBUFFER_GREEN_SIZE                       = 0x8d51
-	RENDERBUFFER_HEIGHT                           = 0x8d43
-	RENDERBUFFER_INTERNAL_FORMAT                  = 0x8d44
-	RENDERBUFFER_RED_SIZE                         = 0x8d50
-	RENDERBUFFER_SAMPLES                          = 0x8cab
-	RENDERBUFFER_STENCIL_SIZE                     = 0x8d55
-	RENDERBUFFER_WIDTH                            = 0x8d42
-	RENDERBUFFER                                  = 0x8d41
-	RENDERER                                      = 0x1f01
-	RENDER_MODE                                   = 0xc40
-	RENDER                                        = 0x1c00
-	REND_screen_coordinates                       = 0x1
-	REPEAT                                        = 0x2901
-	REPLACE                                       = 0x1e01
-	REPLICATE_BORDER                              = 0x8153
-	RESCALE_NORMAL                                = 0x803a
-	RETURN                                        = 0x102
-	RG16F                                         = 0x822f
-	RG16I                                         = 0x8239
-	RG16_SNORM                                    = 0x8f99
-	RG16UI                                        = 0x823a
-	RG16                                          = 0x822c
-	RG32F                                         = 0x8230
-	RG32I                                         = 0x823b
-	RG32UI                                        = 0x823c
-	RG8I                                          = 0x8237
-	RG8_SNORM                                     = 0x8f95
-	RG8UI                                         = 0x8238
-	RG8                                           = 0x822b
-	RGB10_A2                                      = 0x8059
-	RGB10                                         = 0x8052
-	RGB12                                         = 0x8053
-	RGB16F                                        = 0x881b
-	RGB16I                                        = 0x8d89
-	RGB16_SNORM                                   = 0x8f9a
-	RGB16UI                                       = 0x8d77
-	RGB16                                         = 0x8054
-	RGB32F                                        = 0x8815
-	RGB32I                                        = 0x8d83
-	RGB32UI                                       = 0x8d71
-	RGB4                                          = 0x804f
-	RGB5_A1                                       = 0x8057
-	RGB5                                          = 0x8050
-	RGB8I                                         = 0x8d8f
-	RGB8_SNORM                                    = 0x8f96
-	RGB8UI                                        = 0x8d7d
-	RGB8                                          = 0x8051
-	RGB9_E5                                       = 0x8c3d
-	RGBA12                                        = 0x805a
-	RGBA16F                                       = 0x881a
-	RGBA16I                                       = 0x8d88
-	RGBA16_SNORM                                  = 0x8f9b
-	RGBA16UI                                      = 0x8d76
-	RGBA16                                        = 0x805b
-	RGBA2                                         = 0x8055
-	RGBA32F                                       = 0x8814
-	RGBA32I                                       = 0x8d82
-	RGBA32UI                                      = 0x8d70
-	RGBA4                                         = 0x8056
-	RGBA8I                                        = 0x8d8e
-	RGBA8_SNORM                                   = 0x8f97
-	RGBA8UI                                       = 0x8d7c
-	RGBA8                                         = 0x8058
-	RGBA_INTEGER                                  = 0x8d99
-	RGBA_MODE                                     = 0xc31
-	RGBA_SNORM                                    = 0x8f93
-	RGBA                                          = 0x1908
-	RGB_INTEGER                                   = 0x8d98
-	RGB_SCALE                                     = 0x8573
-	RGB_SNORM                                     = 0x8f92
-	RGB                                           = 0x1907
-	RG_INTEGER                                    = 0x8228
-	RG_SNORM                                      = 0x8f91
-	RG                                            = 0x8227
-	RIGHT                                         = 0x407
-	R                                             = 0x2002
-	SAMPLE_ALPHA_TO_COVERAGE                      = 0x809e
-	SAMPLE_ALPHA_TO_ONE                           = 0x809f
-	SAMPLE_BUFFERS                                = 0x80a8
-	SAMPLE_COVERAGE_INVERT                        = 0x80ab
-	SAMPLE_COVERAGE_VALUE                         = 0x80aa
-	SAMPLE_COVERAGE                               = 0x80a0
-	SAMPLE_MASK_VALUE                             = 0x8e52
-	SAMPLE_MASK                                   = 0x8e51
-	SAMPLE_POSITION                               = 0x8e50
-	SAMPLER_1D_ARRAY_SHADOW                       = 0x8dc3
-	SAMPLER_1D_ARRAY                              = 0x8dc0
-	SAMPLER_1D_SHADOW                             = 0x8b61
-	SAMPLER_1D                                    = 0x8b5d
-	SAMPLER_2D_ARRAY_SHADOW                       = 0x8dc4
-	SAMPLER_2D_ARRAY                              = 0x8dc1
-	SAMPLER_2D_MULTISAMPLE_ARRAY                  = 0x910b
-	SAMPLER_2D_MULTISAMPLE                        = 0x9108
-	SAMPLER_2D_RECT_SHADOW                        = 0x8b64
-	SAMPLER_2D_RECT                               = 0x8b63
-	SAMPLER_2D_SHADOW                             = 0x8b62
-	SAMPLER_2D                                    = 0x8b5e
-	SAMPLER_3D                                    = 0x8b5f
-	SAMPLER_BUFFER                                = 0x8dc2
-	SAMPLER_CUBE_MAP_ARRAY_SHADOW                 = 0x900d
-	SAMPLER_CUBE_MAP_ARRAY                        = 0x900c
-	SAMPLER_CUBE_SHADOW                           = 0x8dc5
-	SAMPLER_CUBE                                  = 0x8b60
-	SAMPLE_SHADING                                = 0x8c36
-	SAMPLES_PASSED                                = 0x8914
-	SAMPLES                                       = 0x80a9
-	SCISSOR_BIT                                   = 0x80000
-	SCISSOR_BOX                                   = 0xc10
-	SCISSOR_TEST                                  = 0xc11
-	SCREEN_COORDINATES_REND                       = 0x8490
-	SECONDARY_COLOR_ARRAY_BUFFER_BINDING          = 0x889c
-	SECONDARY_COLOR_ARRAY_POINTER                 = 0x845d
-	SECONDARY_COLOR_ARRAY_SIZE                    = 0x845a
-	SECONDARY_COLOR_ARRAY_STRIDE                  = 0x845c
-	SECONDARY_COLOR_ARRAY_TYPE                    = 0x845b
-	SECONDARY_COLOR_ARRAY                         = 0x845e
-	SELECTION_BUFFER_POINTER                      = 0xdf3
-	SELECTION_BUFFER_SIZE                         = 0xdf4
-	SELECT                                        = 0x1c02
-	SEPARABLE_2D                                  = 0x8012
-	SEPARATE_ATTRIBS                              = 0x8c8d
-	SEPARATE_SPECULAR_COLOR                       = 0x81fa
-	SET                                           = 0x150f
-	SHADE_MODEL                                   = 0xb54
-	SHADER_SOURCE_LENGTH                          = 0x8b88
-	SHADER_TYPE                                   = 0x8b4f
-	SHADING_LANGUAGE_VERSION                      = 0x8b8c
-	SHININESS                                     = 0x1601
-	SHORT                                         = 0x1402
-	SIGNALED                                      = 0x9119
-	SIGNED_NORMALIZED                             = 0x8f9c
-	SINGLE_COLOR                                  = 0x81f9
-	SLUMINANCE8_ALPHA8                            = 0x8c45
-	SLUMINANCE8                                   = 0x8c47
-	SLUMINANCE_ALPHA                              = 0x8c44
-	SLUMINANCE                                    = 0x8c46
-	SMOOTH_LINE_WIDTH_GRANULARITY                 = 0xb23
-	SMOOTH_LINE_WIDTH_RANGE                       = 0xb22
-	SMOOTH_POINT_SIZE_GRANULARITY                 = 0xb13
-	SMOOTH_POINT_SIZE_RANGE                       = 0xb12
-	SMOOTH                                        = 0x1d01
-	SOURCE0_ALPHA                                 = 0x8588
-	SOURCE0_RGB                                   = 0x8580
-	SOURCE1_ALPHA                                 = 0x8589
-	SOURCE1_RGB                                   = 0x8581
-	SOURCE2_ALPHA                                 = 0x858a
-	SOURCE2_RGB                                   = 0x8582
-	SPECULAR                                      = 0x1202
-	SPHERE_MAP                                    = 0x2402
-	SPOT_CUTOFF                                   = 0x1206
-	SPOT_DIRECTION                                = 0x1204
-	SPOT_EXPONENT                                 = 0x1205
-	SRC0_ALPHA                                    = 0x8588
-	SRC0_RGB                                      = 0x8580
-	SRC1_ALPHA                                    = 0x8589
-	SRC1_RGB                                      = 0x8581
-	SRC2_ALPHA                                    = 0x858a
-	SRC2_RGB                                      = 0x8582
-	SRC_ALPHA_SATURATE                            = 0x308
-	SRC_ALPHA                                     = 0x302
-	SRC_COLOR                                     = 0x300
-	SRGB8_ALPHA8                                  = 0x8c43
-	SRGB8                                         = 0x8c41
-	SRGB_ALPHA                                    = 0x8c42
-	SRGB                                          = 0x8c40
-	STACK_OVERFLOW                                = 0x503
-	STACK_UNDERFLOW                               = 0x504
-	STATIC_COPY                                   = 0x88e6
-	STATIC_DRAW                                   = 0x88e4
-	STATIC_READ                                   = 0x88e5
-	STENCIL_ATTACHMENT                            = 0x8d20
-	STENCIL_BACK_FAIL                             = 0x8801
-	STENCIL_BACK_FUNC                             = 0x8800
-	STENCIL_BACK_PASS_DEPTH_FAIL                  = 0x8802
-	STENCIL_BACK_PASS_DEPTH_PASS                  = 0x8803
-	STENCIL_BACK_REF                              = 0x8ca3
-	STENCIL_BACK_VALUE_MASK                       = 0x8ca4
-	STENCIL_BACK_WRITEMASK                        = 0x8ca5
-	STENCIL_BITS                                  = 0xd57
-	STENCIL_BUFFER_BIT                            = 0x400
-	STENCIL_BUFFER                                = 0x8224
-	STENCIL_CLEAR_VALUE                           = 0xb91
-	STENCIL_FAIL                                  = 0xb94
-	STENCIL_FUNC                                  = 0xb92
-	STENCIL_INDEX16                               = 0x8d49
-	STENCIL_INDEX1                                = 0x8d46
-	STENCIL_INDEX4                                = 0x8d47
-	STENCIL_INDEX8                                = 0x8d48
-	STENCIL_INDEX                                 = 0x1901
-	STENCIL_PASS_DEPTH_FAIL                       = 0xb95
-	STENCIL_PASS_DEPTH_PASS                       = 0xb96
-	STENCIL_REF                                   = 0xb97
-	STENCIL_TEST                                  = 0xb90
-	STENCIL_VALUE_MASK                            = 0xb93
-	STENCIL_WRITEMASK                             = 0xb98
-	STENCIL                                       = 0x1802
-	STEREO                                        = 0xc33
-	STREAM_COPY                                   = 0x88e2
-	STREAM_DRAW                                   = 0x88e0
-	STREAM_READ                                   = 0x88e1
-	SUBPIXEL_BITS                                 = 0xd50
-	SUBTRACT                                      = 0x84e7
-	SYNC_CONDITION                                = 0x9113
-	SYNC_FENCE                                    = 0x9116
-	SYNC_FLAGS                                    = 0x9115
-	SYNC_FLUSH_COMMANDS_BIT                       = 0x1
-	SYNC_GPU_COMMANDS_COMPLETE                    = 0x9117
-	SYNC_STATUS                                   = 0x9114
-	S                                             = 0x2000
-	T2F_C3F_V3F                                   = 0x2a2a
-	T2F_C4F_N3F_V3F                               = 0x2a2c
-	T2F_C4UB_V3F                                  = 0x2a29
-	T2F_N3F_V3F                                   = 0x2a2b
-	T2F_V3F                                       = 0x2a27
-	T4F_C4F_N3F_V4F                               = 0x2a2d
-	T4F_V4F                                       = 0x2a28
-	TABLE_TOO_LARGE                               = 0x8031
-	TEXTURE0                                      = 0x84c0
-	TEXTURE10                                     = 0x84ca
-	TEXTURE11                                     = 0x84cb
-	TEXTURE12                                     = 0x84cc
-	TEXTURE13                                     = 0x84cd
-	TEXTURE14                                     = 0x84ce
-	TEXTURE15                                     = 0x84cf
-	TEXTURE16                                     = 0x84d0
-	TEXTURE17                                     = 0x84d1
-	TEXTURE18                                     = 0x84d2
-	TEXTURE19                                     = 0x84d3
-	TEXTURE_1D_ARRAY                              = 0x8c18
-	TEXTURE_1D                                    = 0xde0
-	TEXTURE1                                      = 0x84c1
-	TEXTURE20                                     = 0x84d4
-	TEXTURE21                                     = 0x84d5
-	TEXTURE22                                     = 0x84d6
-	TEXTURE23                                     = 0x84d7
-	TEXTURE24                                     = 0x84d8
-	TEXTURE25                                     = 0x84d9
-	TEXTURE26                                     = 0x84da
-	TEXTURE27                                     = 0x84db
-	TEXTURE28                                     = 0x84dc
-	TEXTURE29                                     = 0x84dd
-	TEXTURE_2D_ARRAY                              = 0x8c1a
-	TEXTURE_2D_MULTISAMPLE_ARRAY                  = 0x9102
-	TEXTURE_2D_MULTISAMPLE                        = 0x9100
-	TEXTURE_2D                                    = 0xde1
-	TEXTURE2                                      = 0x84c2
-	TEXTURE30                                     = 0x84de
-	TEXTURE31                                     = 0x84df
-	TEXTURE_3D                                    = 0x806f
-	TEXTURE3                                      = 0x84c3
-	TEXTURE4                                      = 0x84c4
-	TEXTURE5                                      = 0x84c5
-	TEXTURE6                                      = 0x84c6
-	TEXTURE7                                      = 0x84c7
-	TEXTURE8                                      = 0x84c8
-	TEXTURE9                                      = 0x84c9
-	TEXTURE_ALPHA_SIZE                            = 0x805f
-	TEXTURE_ALPHA_TYPE                            = 0x8c13
-	TEXTURE_BASE_LEVEL                            = 0x813c
-	TEXTURE_BINDING_1D_ARRAY                      = 0x8c1c
-	TEXTURE_BINDING_1D                            = 0x8068
-	TEXTURE_BINDING_2D_ARRAY                      = 0x8c1d
-	TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY          = 0x9105
-	TEXTURE_BINDING_2D_MULTISAMPLE                = 0x9104
-	TEXTURE_BINDING_2D                            = 0x8069
-	TEXTURE_BINDING_3D                            = 0x806a
-	TEXTURE_BINDING_BUFFER                        = 0x8c2c
-	TEXTURE_BINDING_CUBE_MAP_ARRAY                = 0x900a
-	TEXTURE_BINDING_CUBE_MAP                      = 0x8514
-	TEXTURE_BINDING_RECTANGLE                     = 0x84f6
-	TEXTURE_BIT                                   = 0x40000
-	TEXTURE_BLUE_SIZE                             = 0x805e
-	TEXTURE_BLUE_TYPE                             = 0x8c12
-	TEXTURE_BORDER_COLOR                          = 0x1004
-	TEXTURE_BORDER                                = 0x1005
-	TEXTURE_BUFFER_DATA_STORE_BINDING             = 0x8c2d
-	TEXTURE_BUFFER_FORMAT                         = 0x8c2e
-	TEXTURE_BUFFER                                = 0x8c2a
-	TEXTURE_COMPARE_FUNC                          = 0x884d
-	TEXTURE_COMPARE_MODE                          = 0x884c
-	TEXTURE_COMPONENTS                            = 0x1003
-	TEXTURE_COMPRESSED_IMAGE_SIZE                 = 0x86a0
-	TEXTURE_COMPRESSED                            = 0x86a1
-	TEXTURE_COMPRESSION_HINT                      = 0x84ef
-	TEXTURE_COORD_ARRAY_BUFFER_BINDING            = 0x889a
-	TEXTURE_COORD_ARRAY_POINTER                   = 0x8092
-	TEXTURE_COORD_ARRAY_SIZE                      = 0x8088
-	TEXTURE_COORD_ARRAY_STRIDE                    = 0x808a
-	TEXTURE_COORD_ARRAY_TYPE                      = 0x8089
-	TEXTURE_COORD_ARRAY                           = 0x8078
-	TEXTURE_CUBE_MAP_ARRAY                        = 0x9009
-	TEXTURE_CUBE_MAP_NEGATIVE_X                   = 0x8516
-	TEXTURE_CUBE_MAP_NEGATIVE_Y                   = 0x8518
-	TEXTURE_CUBE_MAP_NEGATIVE_Z                   = 0x851a
-	TEXTURE_CUBE_MAP_POSITIVE_X                   = 0x8515
-	TEXTURE_CUBE_MAP_POSITIVE_Y                   = 0x8517
-	TEXTURE_CUBE_MAP_POSITIVE_Z                   = 0x8519
-	TEXTURE_CUBE_MAP_SEAMLESS                     = 0x884f
-	TEXTURE_CUBE_MAP                              = 0x8513
-	TEXTURE_DEPTH_SIZE                            = 0x884a
-	TEXTURE_DEPTH_TYPE                            = 0x8c16
-	TEXTURE_DEPTH                                 = 0x8071
-	TEXTURE_ENV_COLOR                             = 0x2201
-	TEXTURE_ENV_MODE                              = 0x2200
-	TEXTURE_ENV                                   = 0x2300
-	TEXTURE_FILTER_CONTROL                        = 0x8500
-	TEXTURE_FIXED_SAMPLE_LOCATIONS                = 0x9107
-	TEXTURE_GEN_MODE                              = 0x2500
-	TEXTURE_GEN_Q                                 = 0xc63
-	TEXTURE_GEN_R                                 = 0xc62
-	TEXTURE_GEN_S                                 = 0xc60
-	TEXTURE_GEN_T                                 = 0xc61
-	TEXTURE_GREEN_SIZE                            = 0x805d
-	TEXTURE_GREEN_TYPE                            = 0x8c11
-	TEXTURE_HEIGHT                                = 0x1001
-	TEXTURE_INTENSITY_SIZE                        = 0x8061
-	TEXTURE_INTENSITY_TYPE                        = 0x8c15
-	TEXTURE_INTERNAL_FORMAT                       = 0x1003
-	TEXTURE_LOD_BIAS                              = 0x8501
-	TEXTURE_LUMINANCE_SIZE                        = 0x8060
-	TEXTURE_LUMINANCE_TYPE                        = 0x8c14
-	TEXTURE_MAG_FILTER                            = 0x2800
-	TEXTURE_MATRIX                                = 0xba8
-	TEXTURE_MAX_LEVEL                             = 0x813d
-	TEXTURE_MAX_LOD                               = 0x813b
-	TEXTURE_MIN_FILTER                            = 0x2801
-	TEXTURE_MIN_LOD                               = 0x813a
-	TEXTURE_PRIORITY                              = 0x8066
-	TEXTURE_RECTANGLE                             = 0x84f5
-	TEXTURE_RED_SIZE                              = 0x805c
-	TEXTURE_RED_TYPE                              = 0x8c10
-	TEXTURE_RESIDENT                              = 0x8067
-	TEXTURE_SAMPLES                               = 0x9106
-	TEXTURE_SHARED_SIZE                           = 0x8c3f
-	TEXTURE_STACK_DEPTH                           = 0xba5
-	TEXTURE_STENCIL_SIZE                          = 0x88f1
-	TEXTURE_WIDTH                                 = 0x1000
-	TEXTURE_WRAP_R                                = 0x8072
-	TEXTURE_WRAP_S                                = 0x2802
-	TEXTURE_WRAP_T                                = 0x2803
-	TEXTURE                                       = 0x1702
-	TIMEOUT_EXPIRED                               = 0x911b
-	TIMEOUT_IGNORED                               = -0x1
-	TRANSFORM_FEEDBACK                            = 0x8e22
-	TRANSFORM_BIT                                 = 0x1000
-	TRANSFORM_FEEDBACK_BUFFER_BINDING             = 0x8c8f
-	TRANSFORM_FEEDBACK_BUFFER_MODE                = 0x8c7f
-	TRANSFORM_FEEDBACK_BUFFER_SIZE                = 0x8c85
-	TRANSFORM_FEEDBACK_BUFFER_START               = 0x8c84
-	TRANSFORM_FEEDBACK_BUFFER                     = 0x8c8e
-	TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN         = 0x8c88
-	TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH         = 0x8c76
-	TRANSFORM_FEEDBACK_VARYINGS                   = 0x8c83
-	TRANSPOSE_COLOR_MATRIX                        = 0x84e6
-	TRANSPOSE_MODELVIEW_MATRIX                    = 0x84e3
-	TRANSPOSE_PROJECTION_MATRIX                   = 0x84e4
-	TRANSPOSE_TEXTURE_MATRIX                      = 0x84e5
-	TRIANGLE_FAN                                  = 0x6
-	TRIANGLES_ADJACENCY                           = 0xc
-	TRIANGLE_STRIP_ADJACENCY                      = 0xd
-	TRIANGLE_STRIP                                = 0x5
-	TRIANGLES                                     = 0x4
-	TRUE                                          = 0x1
-	T                                             = 0x2001
-	UNIFORM_ARRAY_STRIDE                          = 0x8a3c
-	UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES          = 0x8a43
-	UNIFORM_BLOCK_ACTIVE_UNIFORMS                 = 0x8a42
-	UNIFORM_BLOCK_BINDING                         = 0x8a3f
-	UNIFORM_BLOCK_DATA_SIZE                       = 0x8a40
-	UNIFORM_BLOCK_INDEX                           = 0x8a3a
-	UNIFORM_BLOCK_NAME_LENGTH                     = 0x8a41
-	UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER   = 0x8a46
-	UNIFORM_BLOCK_REFERENCED_BY_GEOMETRY_SHADER   = 0x8a45
-	UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER     = 0x8a44
-	UNIFORM_BUFFER_BINDING                        = 0x8a28
-	UNIFORM_BUFFER_OFFSET_ALIGNMENT               = 0x8a34
-	UNIFORM_BUFFER_SIZE                           = 0x8a2a
-	UNIFORM_BUFFER_START                          = 0x8a29
-	UNIFORM_BUFFER                                = 0x8a11
-	UNIFORM_IS_ROW_MAJOR                          = 0x8a3e
-	UNIFORM_MATRIX_STRIDE                         = 0x8a3d
-	UNIFORM_NAME_LENGTH                           = 0x8a39
-	UNIFORM_OFFSET                                = 0x8a3b
-	UNIFORM_SIZE                                  = 0x8a38
-	UNIFORM_TYPE                                  = 0x8a37
-	UNPACK_ALIGNMENT                              = 0xcf5
-	UNPACK_IMAGE_HEIGHT                           = 0x806e
-	UNPACK_LSB_FIRST                              = 0xcf1
-	UNPACK_ROW_LENGTH                             = 0xcf2
-	UNPACK_SKIP_IMAGES                            = 0x806d
-	UNPACK_SKIP_PIXELS                            = 0xcf4
-	UNPACK_SKIP_ROWS                              = 0xcf3
-	UNPACK_SWAP_BYTES                             = 0xcf0
-	UNSIGNALED                                    = 0x9118
-	UNSIGNED_BYTE_2_3_3_REV                       = 0x8362
-	UNSIGNED_BYTE_3_3_2                           = 0x8032
-	UNSIGNED_BYTE                                 = 0x1401
-	UNSIGNED_INT_10_10_10_2                       = 0x8036
-	UNSIGNED_INT_10F_11F_11F_REV                  = 0x8c3b
-	UNSIGNED_INT_2_10_10_10_REV                   = 0x8368
-	UNSIGNED_INT_24_8                             = 0x84fa
-	UNSIGNED_INT_5_9_9_9_REV                      = 0x8c3e
-	UNSIGNED_INT_8_8_8_8_REV                      = 0x8367
-	UNSIGNED_INT_8_8_8_8                          = 0x8035
-	UNSIGNED_INT_SAMPLER_1D_ARRAY                 = 0x8dd6
-	UNSIGNED_INT_SAMPLER_1D                       = 0x8dd1
-	UNSIGNED_INT_SAMPLER_2D_ARRAY                 = 0x8dd7
-	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE_ARRAY     = 0x910d
-	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE           = 0x910a
-	UNSIGNED_INT_SAMPLER_2D_RECT                  = 0x8dd5
-	UNSIGNED_INT_SAMPLER_2D                       = 0x8dd2
-	UNSIGNED_INT_SAMPLER_3D                       = 0x8dd3
-	UNSIGNED_INT_SAMPLER_BUFFER                   = 0x8dd8
-	UNSIGNED_INT_SAMPLER_CUBE_MAP_ARRAY           = 0x900f
-	UNSIGNED_INT_SAMPLER_CUBE                     = 0x8dd4
-	UNSIGNED_INT_VEC2                             = 0x8dc6
-	UNSIGNED_INT_VEC3                             = 0x8dc7
-	UNSIGNED_INT_VEC4                             = 0x8dc8
-	UNSIGNED_INT                                  = 0x1405
-	UNSIGNED_NORMALIZED                           = 0x8c17
-	UNSIGNED_SHORT_1_5_5_5_REV                    = 0x8366
-	UNSIGNED_SHORT_4_4_4_4_REV                    = 0x8365
-	UNSIGNED_SHORT_4_4_4_4                        = 0x8033
-	UNSIGNED_SHORT_5_5_5_1                        = 0x8034
-	UNSIGNED_SHORT_5_6_5_REV                      = 0x8364
-	UNSIGNED_SHORT_5_6_5                          = 0x8363
-	UNSIGNED_SHORT                                = 0x1403
-	UPPER_LEFT                                    = 0x8ca2
-	V2F                                           = 0x2a20
-	V3F                                           = 0x2a21
-	VALIDATE_STATUS                               = 0x8b83
-	VENDOR                                        = 0x1f00
-	VERSION_1_1                                   = 0x1
-	VERSION_1_2                                   = 0x1
-	VERSION_1_3                                   = 0x1
-	VERSION_1_4                                   = 0x1
-	VERSION_1_5                                   = 0x1
-	VERSION_2_0                                   = 0x1
-	VERSION_2_1                                   = 0x1
-	VERSION_3_0                                   = 0x1
-	VERSION_3_1                                   = 0x1
-	VERSION_3_2                                   = 0x1
-	VERSION                                       = 0x1f02
-	VERTEX_ARRAY_BINDING                          = 0x85b5
-	VERTEX_ARRAY_BUFFER_BINDING                   = 0x8896
-	VERTEX_ARRAY_POINTER                          = 0x808e
-	VERTEX_ARRAY_SIZE                             = 0x807a
-	VERTEX_ARRAY_STRIDE                           = 0x807c
-	VERTEX_ARRAY_TYPE                             = 0x807b
-	VERTEX_ARRAY                                  = 0x8074
-	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING            = 0x889f
-	VERTEX_ATTRIB_ARRAY_ENABLED                   = 0x8622
-	VERTEX_ATTRIB_ARRAY_INTEGER                   = 0x88fd
-	VERTEX_ATTRIB_ARRAY_NORMALIZED                = 0x886a
-	VERTEX_ATTRIB_ARRAY_POINTER                   = 0x8645
-	VERTEX_ATTRIB_ARRAY_SIZE                      = 0x8623
-	VERTEX_ATTRIB_ARRAY_STRIDE                    = 0x8624
-	VERTEX_ATTRIB_ARRAY_TYPE                      = 0x8625
-	VERTEX_PROGRAM_POINT_SIZE                     = 0x8642
-	VERTEX_PROGRAM_TWO_SIDE                       = 0x8643
-	VERTEX_SHADER                                 = 0x8b31
-	VIEWPORT_BIT                                  = 0x800
-	VIEWPORT                                      = 0xba2
-	WAIT_FAILED                                   = 0x911d
-	WEIGHT_ARRAY_BUFFER_BINDING                   = 0x889e
-	WRITE_ONLY                                    = 0x88b9
-	XOR                                           = 0x1506
-	ZERO                                          = 0
-	ZOOM_X                                        = 0xd16
-	ZOOM_Y                                        = 0xd17
+	ACTIVE_ATOMIC_COUNTER_BUFFERS                              = 0x92d9
+	ACTIVE_ATTRIBUTE_MAX_LENGTH                                = 0x8b8a
+	ACTIVE_ATTRIBUTES                                          = 0x8b89
+	ACTIVE_PROGRAM                                             = 0x8259
+	ACTIVE_RESOURCES                                           = 0x92f5
+	ACTIVE_SUBROUTINE_MAX_LENGTH                               = 0x8e48
+	ACTIVE_SUBROUTINE_UNIFORM_LOCATIONS                        = 0x8e47
+	ACTIVE_SUBROUTINE_UNIFORM_MAX_LENGTH                       = 0x8e49
+	ACTIVE_SUBROUTINE_UNIFORMS                                 = 0x8de6
+	ACTIVE_SUBROUTINES                                         = 0x8de5
+	ACTIVE_TEXTURE                                             = 0x84e0
+	ACTIVE_UNIFORM_BLOCK_MAX_NAME_LENGTH                       = 0x8a35
+	ACTIVE_UNIFORM_BLOCKS                                      = 0x8a36
+	ACTIVE_UNIFORM_MAX_LENGTH                                  = 0x8b87
+	ACTIVE_UNIFORMS                                            = 0x8b86
+	ACTIVE_VARIABLES                                           = 0x9305
+	ALIASED_LINE_WIDTH_RANGE                                   = 0x846e
+	ALL_BARRIER_BITS                                           = 0xffffffff
+	ALL_SHADER_BITS                                            = 0xffffffff
+	ALPHA                                                      = 0x1906
+	ALREADY_SIGNALED                                           = 0x911a
+	AND_INVERTED                                               = 0x1504
+	AND_REVERSE                                                = 0x1502
+	AND                                                        = 0x1501
+	ANY_SAMPLES_PASSED_CONSERVATIVE                            = 0x8d6a
+	ANY_SAMPLES_PASSED                                         = 0x8c2f
+	ARRAY_BUFFER_BINDING                                       = 0x8894
+	ARRAY_SIZE                                                 = 0x92fb
+	ARRAY_STRIDE                                               = 0x92fe
+	ATOMIC_COUNTER_BARRIER_BIT                                 = 0x1000
+	ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTER_INDICES        = 0x92c6
+	ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTERS               = 0x92c5
+	ATOMIC_COUNTER_BUFFER_BINDING                              = 0x92c1
+	ATOMIC_COUNTER_BUFFER_DATA_SIZE                            = 0x92c4
+	ATOMIC_COUNTER_BUFFER_INDEX                                = 0x9301
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_COMPUTE_SHADER         = 0x90ed
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_FRAGMENT_SHADER        = 0x92cb
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_GEOMETRY_SHADER        = 0x92ca
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_TESS_CONTROL_SHADER    = 0x92c8
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_TESS_EVALUATION_SHADER = 0x92c9
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_VERTEX_SHADER          = 0x92c7
+	ATOMIC_COUNTER_BUFFER_SIZE                                 = 0x92c3
+	ATOMIC_COUNTER_BUFFER_START                                = 0x92c2
+	ATTACHED_SHADERS                                           = 0x8b85
+	AUTO_GENERATE_MIPMAP                                       = 0x8295
+	BACK_LEFT                                                  = 0x402
+	BACK_RIGHT                                                 = 0x403
+	BACK                                                       = 0x405
+	BGR_INTEGER                                                = 0x8d9a
+	BGRA_INTEGER                                               = 0x8d9b
+	BGRA                                                       = 0x80e1
+	BGR                                                        = 0x80e0
+	BLEND_COLOR                                                = 0x8005
+	BLEND_DST_ALPHA                                            = 0x80ca
+	BLEND_DST_RGB                                              = 0x80c8
+	BLEND_DST                                                  = 0xbe0
+	BLEND_EQUATION_ALPHA                                       = 0x883d
+	BLEND_EQUATION_RGB                                         = 0x8009
+	BLEND_EQUATION                                             = 0x8009
+	BLEND_SRC_ALPHA                                            = 0x80cb
+	BLEND_SRC_RGB                                              = 0x80c9
+	BLEND_SRC                                                  = 0xbe1
+	BLOCK_INDEX                                                = 0x92fd
+	BLUE_INTEGER                                               = 0x8d96
+	BLUE                                                       = 0x1905
+	BOOL_VEC2                                                  = 0x8b57
+	BOOL_VEC3                                                  = 0x8b58
+	BOOL_VEC4                                                  = 0x8b59
+	BOOL                                                       = 0x8b56
+	BUFFER_ACCESS_FLAGS                                        = 0x911f
+	BUFFER_ACCESS                                              = 0x88bb
+	BUFFER_BINDING                                             = 0x9302
+	BUFFER_DATA_SIZE                                           = 0x9303
+	BUFFER_MAP_LENGTH                                          = 0x9120
+	BUFFER_MAP_OFFSET                                          = 0x9121
+	BUFFER_MAP_POINTER                                         = 0x88bd
+	BUFFER_MAPPED                                              = 0x88bc
+	BUFFER_SIZE                                                = 0x8764
+	BUFFER_UPDATE_BARRIER_BIT                                  = 0x200
+	BUFFER_USAGE                                               = 0x8765
+	BUFFER_VARIABLE                                            = 0x92e5
+	BUFFER                                                     = 0x82e0
+	BYTE                                                       = 0x1400
+	CAVEAT_SUPPORT                                             = 0x82b8
+	CLAMP_READ_COLOR                                           = 0x891c
+	CLAMP_TO_BORDER                                            = 0x812d
+	CLAMP_TO_EDGE                                              = 0x812f
+	CLEAR_BUFFER                                               = 0x82b4
+	CLEAR                                                      = 0x1500
+	CLIP_DEPTH_MODE                                            = 0x935d
+	CLIP_DISTANCE0                                             = 0x3000
+	CLIP_DISTANCE1                                             = 0x3001
+	CLIP_DISTANCE2                                             = 0x3002
+	CLIP_DISTANCE3                                             = 0x3003
+	CLIP_DISTANCE4                                             = 0x3004
+	CLIP_DISTANCE5                                             = 0x3005
+	CLIP_DISTANCE6                                             = 0x3006
+	CLIP_DISTANCE7                                             = 0x3007
+	CLIP_ORIGIN                                                = 0x935c
+	COLOR_ATTACHMENT0                                          = 0x8ce0
+	COLOR_ATTACHMENT10                                         = 0x8cea
+	COLOR_ATTACHMENT11                                         = 0x8ceb
+	COLOR_ATTACHMENT12                                         = 0x8cec
+	COLOR_ATTACHMENT13                                         = 0x8ced
+	COLOR_ATTACHMENT14                                         = 0x8cee
+	COLOR_ATTACHMENT15                                         = 0x8cef
+	COLOR_ATTACHMENT16                                         = 0x8cf0
+	COLOR_ATTACHMENT17                                         = 0x8cf1
+	COLOR_ATTACHMENT18                                         = 0x8cf2
+	COLOR_ATTACHMENT19                                         = 0x8cf3
+	COLOR_ATTACHMENT1                                          = 0x8ce1
+	COLOR_ATTACHMENT20                                         = 0x8cf4
+	COLOR_ATTACHMENT21                                         = 0x8cf5
+	COLOR_ATTACHMENT22                                         = 0x8cf6
+	COLOR_ATTACHMENT23                                         = 0x8cf7
+	COLOR_ATTACHMENT24                                         = 0x8cf8
+	COLOR_ATTACHMENT25                                         = 0x8cf9
+	COLOR_ATTACHMENT26                                         = 0x8cfa
+	COLOR_ATTACHMENT27                                         = 0x8cfb
+	COLOR_ATTACHMENT28                                         = 0x8cfc
+	COLOR_ATTACHMENT29                                         = 0x8cfd
+	COLOR_ATTACHMENT2                                          = 0x8ce2
+	COLOR_ATTACHMENT30                                         = 0x8cfe
+	COLOR_ATTACHMENT31                                         = 0x8cff
+	COLOR_ATTACHMENT3                                          = 0x8ce3
+	COLOR_ATTACHMENT4                                          = 0x8ce4
+	COLOR_ATTACHMENT5                                          = 0x8ce5
+	COLOR_ATTACHMENT6                                          = 0x8ce6
+	COLOR_ATTACHMENT7                                          = 0x8ce7
+	COLOR_ATTACHMENT8                                          = 0x8ce8
+	COLOR_ATTACHMENT9                                          = 0x8ce9
+	COLOR_CLEAR_VALUE                                          = 0xc22
+	COLOR_COMPONENTS                                           = 0x8283
+	COLOR_ENCODING                                             = 0x8296
+	COLOR_RENDERABLE                                           = 0x8286
+	COLOR_WRITEMASK                                            = 0xc23
+	COLOR                                                      = 0x1800
+	COMMAND_BARRIER_BIT                                        = 0x40
+	COMPARE_REF_TO_TEXTURE                                     = 0x884e
+	COMPATIBLE_SUBROUTINES                                     = 0x8e4b
+	COMPILE_STATUS                                             = 0x8b81
+	COMPRESSED_R11_EAC                                         = 0x9270
+	COMPRESSED_RED_RGTC1                                       = 0x8dbb
+	COMPRESSED_RED                                             = 0x8225
+	COMPRESSED_RG_RGTC2                                        = 0x8dbd
+	COMPRESSED_RG11_EAC                                        = 0x9272
+	COMPRESSED_RGB_BPTC_SIGNED_FLOAT                           = 0x8e8e
+	COMPRESSED_RGB_BPTC_UNSIGNED_FLOAT                         = 0x8e8f
+	COMPRESSED_RGB8_ETC2                                       = 0x9274
+	COMPRESSED_RGB8_PUNCHTHROUGH_ALPHA1_ETC2                   = 0x9276
+	COMPRESSED_RGBA_BPTC_UNORM                                 = 0x8e8c
+	COMPRESSED_RGBA8_ETC2_EAC                                  = 0x9278
+	COMPRESSED_RGBA                                            = 0x84ee
+	COMPRESSED_RGB                                             = 0x84ed
+	COMPRESSED_RG                                              = 0x8226
+	COMPRESSED_SIGNED_R11_EAC                                  = 0x9271
+	COMPRESSED_SIGNED_RED_RGTC1                                = 0x8dbc
+	COMPRESSED_SIGNED_RG_RGTC2                                 = 0x8dbe
+	COMPRESSED_SIGNED_RG11_EAC                                 = 0x9273
+	COMPRESSED_SRGB_ALPHA_BPTC_UNORM                           = 0x8e8d
+	COMPRESSED_SRGB_ALPHA                                      = 0x8c49
+	COMPRESSED_SRGB8_ALPHA8_ETC2_EAC                           = 0x9279
+	COMPRESSED_SRGB8_ETC2                                      = 0x9275
+	COMPRESSED_SRGB8_PUNCHTHROUGH_ALPHA1_ETC2                  = 0x9277
+	COMPRESSED_SRGB                                            = 0x8c48
+	COMPRESSED_TEXTURE_FORMATS                                 = 0x86a3
+	COMPUTE_SHADER_BIT                                         = 0x20
+	COMPUTE_SUBROUTINE_UNIFORM                                 = 0x92f3
+	COMPUTE_SUBROUTINE                                         = 0x92ed
+	COMPUTE_TEXTURE                                            = 0x82a0
+	COMPUTE_WORK_GROUP_SIZE                                    = 0x8267
+	CONDITION_SATISFIED                                        = 0x911c
+	CONTEXT_COMPATIBILITY_PROFILE_BIT                          = 0x2
+	CONTEXT_CORE_PROFILE_BIT                                   = 0x1
+	CONTEXT_FLAG_DEBUG_BIT                                     = 0x2
+	CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT                        = 0x1
+	CONTEXT_FLAGS                                              = 0x821e
+	CONTEXT_PROFILE_MASK                                       = 0x9126
+	COPY_INVERTED                                              = 0x150c
+	COPY_READ_BUFFER_BINDING                                   = 0x8f36
+	COPY_WRITE_BUFFER_BINDING                                  = 0x8f37
+	COPY                                                       = 0x1503
+	CULL_FACE_MODE                                             = 0xb45
+	CURRENT_PROGRAM                                            = 0x8b8d
+	CURRENT_QUERY                                              = 0x8865
+	CURRENT_VERTEX_ATTRIB                                      = 0x8626
+	DEBUG_CALLBACK_FUNCTION_ARB                                = 0x8244
+	DEBUG_CALLBACK_FUNCTION                                    = 0x8244
+	DEBUG_CALLBACK_USER_PARAM_ARB                              = 0x8245
+	DEBUG_CALLBACK_USER_PARAM                                  = 0x8245
+	DEBUG_GROUP_STACK_DEPTH                                    = 0x826d
+	DEBUG_LOGGED_MESSAGES_ARB                                  = 0x9145
+	DEBUG_LOGGED_MESSAGES                                      = 0x9145
+	DEBUG_NEXT_LOGGED_MESSAGE_LENGTH_ARB                       = 0x8243
+	DEBUG_NEXT_LOGGED_MESSAGE_LENGTH                           = 0x8243
+	DEBUG_OUTPUT_SYNCHRONOUS_ARB                               = 0x8242
+	DEBUG_SEVERITY_HIGH_ARB                                    = 0x9146
+	DEBUG_SEVERITY_HIGH                                        = 0x9146
+	DEBUG_SEVERITY_LOW_ARB                                     = 0x9148
+	DEBUG_SEVERITY_LOW                                         = 0x9148
+	DEBUG_SEVERITY_MEDIUM_ARB                                  = 0x9147
+	DEBUG_SEVERITY_MEDIUM                                      = 0x9147
+	DEBUG_SEVERITY_NOTIFICATION                                = 0x826b
+	DEBUG_SOURCE_API_ARB                                       = 0x8246
+	DEBUG_SOURCE_API                                           = 0x8246
+	DEBUG_SOURCE_APPLICATION_ARB                               = 0x824a
+	DEBUG_SOURCE_APPLICATION                                   = 0x824a
+	DEBUG_SOURCE_OTHER_ARB                                     = 0x824b
+	DEBUG_SOURCE_OTHER                                         = 0x824b
+	DEBUG_SOURCE_SHADER_COMPILER_ARB                           = 0x8248
+	DEBUG_SOURCE_SHADER_COMPILER                               = 0x8248
+	DEBUG_SOURCE_THIRD_PARTY_ARB                               = 0x8249
+	DEBUG_SOURCE_THIRD_PARTY                                   = 0x8249
+	DEBUG_SOURCE_WINDOW_SYSTEM_ARB                             = 0x8247
+	DEBUG_SOURCE_WINDOW_SYSTEM                                 = 0x8247
+	DEBUG_TYPE_DEPRECATED_BEHAVIOR_ARB                         = 0x824d
+	DEBUG_TYPE_DEPRECATED_BEHAVIOR                             = 0x824d
+	DEBUG_TYPE_ERROR_ARB                                       = 0x824c
+	DEBUG_TYPE_ERROR                                           = 0x824c
+	DEBUG_TYPE_MARKER                                          = 0x8268
+	DEBUG_TYPE_OTHER_ARB                                       = 0x8251
+	DEBUG_TYPE_OTHER                                           = 0x8251
+	DEBUG_TYPE_PERFORMANCE_ARB                                 = 0x8250
+	DEBUG_TYPE_PERFORMANCE                                     = 0x8250
+	DEBUG_TYPE_POP_GROUP                                       = 0x826a
+	DEBUG_TYPE_PORTABILITY_ARB                                 = 0x824f
+	DEBUG_TYPE_PORTABILITY                                     = 0x824f
+	DEBUG_TYPE_PUSH_GROUP                                      = 0x8269
+	DEBUG_TYPE_UNDEFINED_BEHAVIOR_ARB                          = 0x824e
+	DEBUG_TYPE_UNDEFINED_BEHAVIOR                              = 0x824e
+	DELETE_STATUS                                              = 0x8b80
+	DEPTH_ATTACHMENT                                           = 0x8d00
+	DEPTH_CLEAR_VALUE                                          = 0xb73
+	DEPTH_COMPONENT16                                          = 0x81a5
+	DEPTH_COMPONENT24                                          = 0x81a6
+	DEPTH_COMPONENT32F                                         = 0x8cac
+	DEPTH_COMPONENT32                                          = 0x81a7
+	DEPTH_COMPONENTS                                           = 0x8284
+	DEPTH_COMPONENT                                            = 0x1902
+	DEPTH_FUNC                                                 = 0xb74
+	DEPTH_RANGE                                                = 0xb70
+	DEPTH_RENDERABLE                                           = 0x8287
+	DEPTH_STENCIL_ATTACHMENT                                   = 0x821a
+	DEPTH_STENCIL_TEXTURE_MODE                                 = 0x90ea
+	DEPTH_STENCIL                                              = 0x84f9
+	DEPTH_WRITEMASK                                            = 0xb72
+	DEPTH24_STENCIL8                                           = 0x88f0
+	DEPTH32F_STENCIL8                                          = 0x8cad
+	DEPTH                                                      = 0x1801
+	DISPATCH_INDIRECT_BUFFER_BINDING                           = 0x90ef
+	DISPLAY_LIST                                               = 0x82e7
+	DONT_CARE                                                  = 0x1100
+	DOUBLE_MAT2x3                                              = 0x8f49
+	DOUBLE_MAT2x4                                              = 0x8f4a
+	DOUBLE_MAT2                                                = 0x8f46
+	DOUBLE_MAT3x2                                              = 0x8f4b
+	DOUBLE_MAT3x4                                              = 0x8f4c
+	DOUBLE_MAT3                                                = 0x8f47
+	DOUBLE_MAT4x2                                              = 0x8f4d
+	DOUBLE_MAT4x3                                              = 0x8f4e
+	DOUBLE_MAT4                                                = 0x8f48
+	DOUBLE_VEC2                                                = 0x8ffc
+	DOUBLE_VEC3                                                = 0x8ffd
+	DOUBLE_VEC4                                                = 0x8ffe
+	DOUBLEBUFFER                                               = 0xc32
+	DOUBLE                                                     = 0x140a
+	DRAW_BUFFER0                                               = 0x8825
+	DRAW_BUFFER10                                              = 0x882f
+	DRAW_BUFFER11                                              = 0x8830
+	DRAW_BUFFER12                                              = 0x8831
+	DRAW_BUFFER13                                              = 0x8832
+	DRAW_BUFFER14                                              = 0x8833
+	DRAW_BUFFER15                                              = 0x8834
+	DRAW_BUFFER1                                               = 0x8826
+	DRAW_BUFFER2                                               = 0x8827
+	DRAW_BUFFER3                                               = 0x8828
+	DRAW_BUFFER4                                               = 0x8829
+	DRAW_BUFFER5                                               = 0x882a
+	DRAW_BUFFER6                                               = 0x882b
+	DRAW_BUFFER7                                               = 0x882c
+	DRAW_BUFFER8                                               = 0x882d
+	DRAW_BUFFER9                                               = 0x882e
+	DRAW_BUFFER                                                = 0xc01
+	DRAW_FRAMEBUFFER_BINDING                                   = 0x8ca6
+	DRAW_INDIRECT_BUFFER_BINDING                               = 0x8f43
+	ELEMENT_ARRAY_BARRIER_BIT                                  = 0x2
+	ELEMENT_ARRAY_BUFFER_BINDING                               = 0x8895
+	EQUIV                                                      = 0x1509
+	EXTENSIONS                                                 = 0x1f03
+	FALSE                                                      = 0
+	FASTEST                                                    = 0x1101
+	FILL                                                       = 0x1b02
+	FILTER                                                     = 0x829a
+	FIRST_VERTEX_CONVENTION                                    = 0x8e4d
+	FIXED_ONLY                                                 = 0x891d
+	FIXED                                                      = 0x140c
+	FLOAT_32_UNSIGNED_INT_24_8_REV                             = 0x8dad
+	FLOAT_MAT2x3                                               = 0x8b65
+	FLOAT_MAT2x4                                               = 0x8b66
+	FLOAT_MAT2                                                 = 0x8b5a
+	FLOAT_MAT3x2                                               = 0x8b67
+	FLOAT_MAT3x4                                               = 0x8b68
+	FLOAT_MAT3                                                 = 0x8b5b
+	FLOAT_MAT4x2                                               = 0x8b69
+	FLOAT_MAT4x3                                               = 0x8b6a
+	FLOAT_MAT4                                                 = 0x8b5c
+	FLOAT_VEC2                                                 = 0x8b50
+	FLOAT_VEC3                                                 = 0x8b51
+	FLOAT_VEC4                                                 = 0x8b52
+	FLOAT                                                      = 0x1406
+	FRACTIONAL_EVEN                                            = 0x8e7c
+	FRACTIONAL_ODD                                             = 0x8e7b
+	FRAGMENT_INTERPOLATION_OFFSET_BITS                         = 0x8e5d
+	FRAGMENT_SHADER_BIT                                        = 0x2
+	FRAGMENT_SHADER_DERIVATIVE_HINT                            = 0x8b8b
+	FRAGMENT_SUBROUTINE_UNIFORM                                = 0x92f2
+	FRAGMENT_SUBROUTINE                                        = 0x92ec
+	FRAGMENT_TEXTURE                                           = 0x829f
+	FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE                          = 0x8215
+	FRAMEBUFFER_ATTACHMENT_BLUE_SIZE                           = 0x8214
+	FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING                      = 0x8210
+	FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE                      = 0x8211
+	FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE                          = 0x8216
+	FRAMEBUFFER_ATTACHMENT_GREEN_SIZE                          = 0x8213
+	FRAMEBUFFER_ATTACHMENT_LAYERED                             = 0x8da7
+	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME                         = 0x8cd1
+	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE                         = 0x8cd0
+	FRAMEBUFFER_ATTACHMENT_RED_SIZE                            = 0x8212
+	FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE                        = 0x8217
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE               = 0x8cd3
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER                       = 0x8cd4
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL                       = 0x8cd2
+	FRAMEBUFFER_BARRIER_BIT                                    = 0x400
+	FRAMEBUFFER_BINDING                                        = 0x8ca6
+	FRAMEBUFFER_BLEND                                          = 0x828b
+	FRAMEBUFFER_COMPLETE                                       = 0x8cd5
+	FRAMEBUFFER_DEFAULT_FIXED_SAMPLE_LOCATIONS                 = 0x9314
+	FRAMEBUFFER_DEFAULT_HEIGHT                                 = 0x9311
+	FRAMEBUFFER_DEFAULT_LAYERS                                 = 0x9312
+	FRAMEBUFFER_DEFAULT_SAMPLES                                = 0x9313
+	FRAMEBUFFER_DEFAULT_WIDTH                                  = 0x9310
+	FRAMEBUFFER_DEFAULT                                        = 0x8218
+	FRAMEBUFFER_INCOMPLETE_ATTACHMENT                          = 0x8cd6
+	FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER                         = 0x8cdb
+	FRAMEBUFFER_INCOMPLETE_LAYER_TARGETS                       = 0x8da8
+	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT                  = 0x8cd7
+	FRAMEBUFFER_INCOMPLETE_MULTISAMPLE                         = 0x8d56
+	FRAMEBUFFER_INCOMPLETE_READ_BUFFER                         = 0x8cdc
+	FRAMEBUFFER_RENDERABLE_LAYERED                             = 0x828a
+	FRAMEBUFFER_RENDERABLE                                     = 0x8289
+	FRAMEBUFFER_UNDEFINED                                      = 0x8219
+	FRAMEBUFFER_UNSUPPORTED                                    = 0x8cdd
+	FRONT_AND_BACK                                             = 0x408
+	FRONT_FACE                                                 = 0xb46
+	FRONT_LEFT                                                 = 0x400
+	FRONT_RIGHT                                                = 0x401
+	FRONT                                                      = 0x404
+	FULL_SUPPORT                                               = 0x82b7
+	GEOMETRY_INPUT_TYPE                                        = 0x8917
+	GEOMETRY_OUTPUT_TYPE                                       = 0x8918
+	GEOMETRY_SHADER_BIT                                        = 0x4
+	GEOMETRY_SHADER_INVOCATIONS                                = 0x887f
+	GEOMETRY_SUBROUTINE_UNIFORM                                = 0x92f1
+	GEOMETRY_SUBROUTINE                                        = 0x92eb
+	GEOMETRY_TEXTURE                                           = 0x829e
+	GEOMETRY_VERTICES_OUT                                      = 0x8916
+	GET_TEXTURE_IMAGE_FORMAT                                   = 0x8291
+	GET_TEXTURE_IMAGE_TYPE                                     = 0x8292
+	GREEN_INTEGER                                              = 0x8d95
+	GREEN                                                      = 0x1904
+	HALF_FLOAT                                                 = 0x140b
+	HIGH_FLOAT                                                 = 0x8df2
+	HIGH_INT                                                   = 0x8df5
+	IMAGE_1D_ARRAY                                             = 0x9052
+	IMAGE_1D                                                   = 0x904c
+	IMAGE_2D_ARRAY                                             = 0x9053
+	IMAGE_2D_MULTISAMPLE_ARRAY                                 = 0x9056
+	IMAGE_2D_MULTISAMPLE                                       = 0x9055
+	IMAGE_2D_RECT                                              = 0x904f
+	IMAGE_2D                                                   = 0x904d
+	IMAGE_3D                                                   = 0x904e
+	IMAGE_BINDING_ACCESS                                       = 0x8f3e
+	IMAGE_BINDING_FORMAT                                       = 0x906e
+	IMAGE_BINDING_LAYERED                                      = 0x8f3c
+	IMAGE_BINDING_LAYER                                        = 0x8f3d
+	IMAGE_BINDING_LEVEL                                        = 0x8f3b
+	IMAGE_BINDING_NAME                                         = 0x8f3a
+	IMAGE_BUFFER                                               = 0x9051
+	IMAGE_CLASS_1_X_16                                         = 0x82be
+	IMAGE_CLASS_1_X_32                                         = 0x82bb
+	IMAGE_CLASS_1_X_8                                          = 0x82c1
+	IMAGE_CLASS_10_10_10_2                                     = 0x82c3
+	IMAGE_CLASS_11_11_10                                       = 0x82c2
+	IMAGE_CLASS_2_X_16                                         = 0x82bd
+	IMAGE_CLASS_2_X_32                                         = 0x82ba
+	IMAGE_CLASS_2_X_8                                          = 0x82c0
+	IMAGE_CLASS_4_X_16                                         = 0x82bc
+	IMAGE_CLASS_4_X_32                                         = 0x82b9
+	IMAGE_CLASS_4_X_8                                          = 0x82bf
+	IMAGE_COMPATIBILITY_CLASS                                  = 0x82a8
+	IMAGE_CUBE_MAP_ARRAY                                       = 0x9054
+	IMAGE_CUBE                                                 = 0x9050
+	IMAGE_FORMAT_COMPATIBILITY_BY_CLASS                        = 0x90c9
+	IMAGE_FORMAT_COMPATIBILITY_BY_SIZE                         = 0x90c8
+	IMAGE_FORMAT_COMPATIBILITY_TYPE                            = 0x90c7
+	IMAGE_PIXEL_FORMAT                                         = 0x82a9
+	IMAGE_PIXEL_TYPE                                           = 0x82aa
+	IMAGE_TEXEL_SIZE                                           = 0x82a7
+	IMPLEMENTATION_COLOR_READ_FORMAT                           = 0x8b9b
+	IMPLEMENTATION_COLOR_READ_TYPE                             = 0x8b9a
+	INFO_LOG_LENGTH                                            = 0x8b84
+	INT_2_10_10_10_REV                                         = 0x8d9f
+	INT_IMAGE_1D_ARRAY                                         = 0x905d
+	INT_IMAGE_1D                                               = 0x9057
+	INT_IMAGE_2D_ARRAY                                         = 0x905e
+	INT_IMAGE_2D_MULTISAMPLE_ARRAY                             = 0x9061
+	INT_IMAGE_2D_MULTISAMPLE                                   = 0x9060
+	INT_IMAGE_2D_RECT                                          = 0x905a
+	INT_IMAGE_2D                                               = 0x9058
+	INT_IMAGE_3D                                               = 0x9059
+	INT_IMAGE_BUFFER                                           = 0x905c
+	INT_IMAGE_CUBE_MAP_ARRAY                                   = 0x905f
+	INT_IMAGE_CUBE                                             = 0x905b
+	INT_SAMPLER_1D_ARRAY                                       = 0x8dce
+	INT_SAMPLER_1D                                             = 0x8dc9
+	INT_SAMPLER_2D_ARRAY                                       = 0x8dcf
+	INT_SAMPLER_2D_MULTISAMPLE_ARRAY                           = 0x910c
+	INT_SAMPLER_2D_MULTISAMPLE                                 = 0x9109
+	INT_SAMPLER_2D_RECT                                        = 0x8dcd
+	INT_SAMPLER_2D                                             = 0x8dca
+	INT_SAMPLER_3D                                             = 0x8dcb
+	INT_SAMPLER_BUFFER                                         = 0x8dd0
+	INT_SAMPLER_CUBE_MAP_ARRAY                                 = 0x900e
+	INT_SAMPLER_CUBE                                           = 0x8dcc
+	INT_VEC2                                                   = 0x8b53
+	INT_VEC3                                                   = 0x8b54
+	INT_VEC4                                                   = 0x8b55
+	INTERLEAVED_ATTRIBS                                        = 0x8c8c
+	INTERNALFORMAT_ALPHA_SIZE                                  = 0x8274
+	INTERNALFORMAT_ALPHA_TYPE                                  = 0x827b
+	INTERNALFORMAT_BLUE_SIZE                                   = 0x8273
+	INTERNALFORMAT_BLUE_TYPE                                   = 0x827a
+	INTERNALFORMAT_DEPTH_SIZE                                  = 0x8275
+	INTERNALFORMAT_DEPTH_TYPE                                  = 0x827c
+	INTERNALFORMAT_GREEN_SIZE                                  = 0x8272
+	INTERNALFORMAT_GREEN_TYPE                                  = 0x8279
+	INTERNALFORMAT_PREFERRED                                   = 0x8270
+	INTERNALFORMAT_RED_SIZE                                    = 0x8271
+	INTERNALFORMAT_RED_TYPE                                    = 0x8278
+	INTERNALFORMAT_SHARED_SIZE                                 = 0x8277
+	INTERNALFORMAT_STENCIL_SIZE                                = 0x8276
+	INTERNALFORMAT_STENCIL_TYPE                                = 0x827d
+	INTERNALFORMAT_SUPPORTED                                   = 0x826f
+	INT                                                        = 0x1404
+	INVALID_ENUM                                               = 0x500
+	INVALID_FRAMEBUFFER_OPERATION                              = 0x506
+	INVALID_INDEX                                              = 0xffffffff
+	INVALID_OPERATION                                          = 0x502
+	INVALID_VALUE                                              = 0x501
+	IS_PER_PATCH                                               = 0x92e7
+	IS_ROW_MAJOR                                               = 0x9300
+	ISOLINES                                                   = 0x8e7a
+	LAST_VERTEX_CONVENTION                                     = 0x8e4e
+	LAYER_PROVOKING_VERTEX                                     = 0x825e
+	LEFT                                                       = 0x406
+	LINE_SMOOTH_HINT                                           = 0xc52
+	LINE_WIDTH_GRANULARITY                                     = 0xb23
+	LINE_WIDTH_RANGE                                           = 0xb22
+	LINE_WIDTH                                                 = 0xb21
+	LINEAR_MIPMAP_LINEAR                                       = 0x2703
+	LINEAR_MIPMAP_NEAREST                                      = 0x2701
+	LINEAR                                                     = 0x2601
+	LINE                                                       = 0x1b01
+	LINK_STATUS                                                = 0x8b82
+	LOCATION_INDEX                                             = 0x930f
+	LOCATION                                                   = 0x930e
+	LOGIC_OP_MODE                                              = 0xbf0
+	LOW_FLOAT                                                  = 0x8df0
+	LOW_INT                                                    = 0x8df3
+	LOWER_LEFT                                                 = 0x8ca1
+	MAJOR_VERSION                                              = 0x821b
+	MANUAL_GENERATE_MIPMAP                                     = 0x8294
+	MAP_FLUSH_EXPLICIT_BIT                                     = 0x10
+	MAP_INVALIDATE_BUFFER_BIT                                  = 0x8
+	MAP_INVALIDATE_RANGE_BIT                                   = 0x4
+	MAP_READ_BIT                                               = 0x1
+	MAP_UNSYNCHRONIZED_BIT                                     = 0x20
+	MAP_WRITE_BIT                                              = 0x2
+	MATRIX_STRIDE                                              = 0x92ff
+	MAX_3D_TEXTURE_SIZE                                        = 0x8073
+	MAX_ARRAY_TEXTURE_LAYERS                                   = 0x88ff
+	MAX_ATOMIC_COUNTER_BUFFER_BINDINGS                         = 0x92dc
+	MAX_ATOMIC_COUNTER_BUFFER_SIZE                             = 0x92d8
+	MAX_CLIP_DISTANCES                                         = 0xd32
+	MAX_COLOR_ATTACHMENTS                                      = 0x8cdf
+	MAX_COLOR_TEXTURE_SAMPLES                                  = 0x910e
+	MAX_COMBINED_ATOMIC_COUNTER_BUFFERS                        = 0x92d1
+	MAX_COMBINED_ATOMIC_COUNTERS                               = 0x92d7
+	MAX_COMBINED_COMPUTE_UNIFORM_COMPONENTS                    = 0x8266
+	MAX_COMBINED_DIMENSIONS                                    = 0x8282
+	MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS                   = 0x8a33
+	MAX_COMBINED_GEOMETRY_UNIFORM_COMPONENTS                   = 0x8a32
+	MAX_COMBINED_IMAGE_UNIFORMS                                = 0x90cf
+	MAX_COMBINED_IMAGE_UNITS_AND_FRAGMENT_OUTPUTS              = 0x8f39
+	MAX_COMBINED_SHADER_OUTPUT_RESOURCES                       = 0x8f39
+	MAX_COMBINED_SHADER_STORAGE_BLOCKS                         = 0x90dc
+	MAX_COMBINED_TESS_CONTROL_UNIFORM_COMPONENTS               = 0x8e1e
+	MAX_COMBINED_TESS_EVALUATION_UNIFORM_COMPONENTS            = 0x8e1f
+	MAX_COMBINED_TEXTURE_IMAGE_UNITS                           = 0x8b4d
+	MAX_COMBINED_UNIFORM_BLOCKS                                = 0x8a2e
+	MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS                     = 0x8a31
+	MAX_COMPUTE_ATOMIC_COUNTER_BUFFERS                         = 0x8264
+	MAX_COMPUTE_ATOMIC_COUNTERS                                = 0x8265
+	MAX_COMPUTE_IMAGE_UNIFORMS                                 = 0x91bd
+	MAX_COMPUTE_SHADER_STORAGE_BLOCKS                          = 0x90db
+	MAX_COMPUTE_SHARED_MEMORY_SIZE                             = 0x8262
+	MAX_COMPUTE_TEXTURE_IMAGE_UNITS                            = 0x91bc
+	MAX_COMPUTE_UNIFORM_BLOCKS                                 = 0x91bb
+	MAX_COMPUTE_UNIFORM_COMPONENTS                             = 0x8263
+	MAX_COMPUTE_WORK_GROUP_COUNT                               = 0x91be
+	MAX_COMPUTE_WORK_GROUP_INVOCATIONS                         = 0x90eb
+	MAX_COMPUTE_WORK_GROUP_SIZE                                = 0x91bf
+	MAX_CUBE_MAP_TEXTURE_SIZE                                  = 0x851c
+	MAX_DEBUG_GROUP_STACK_DEPTH                                = 0x826c
+	MAX_DEBUG_LOGGED_MESSAGES_ARB                              = 0x9144
+	MAX_DEBUG_LOGGED_MESSAGES                                  = 0x9144
+	MAX_DEBUG_MESSAGE_LENGTH_ARB                               = 0x9143
+	MAX_DEBUG_MESSAGE_LENGTH                                   = 0x9143
+	MAX_DEPTH_TEXTURE_SAMPLES                                  = 0x910f
+	MAX_DEPTH                                                  = 0x8280
+	MAX_DRAW_BUFFERS                                           = 0x8824
+	MAX_DUAL_SOURCE_DRAW_BUFFERS                               = 0x88fc
+	MAX_ELEMENT_INDEX                                          = 0x8d6b
+	MAX_ELEMENTS_INDICES                                       = 0x80e9
+	MAX_ELEMENTS_VERTICES                                      = 0x80e8
+	MAX_FRAGMENT_ATOMIC_COUNTER_BUFFERS                        = 0x92d0
+	MAX_FRAGMENT_ATOMIC_COUNTERS                               = 0x92d6
+	MAX_FRAGMENT_IMAGE_UNIFORMS                                = 0x90ce
+	MAX_FRAGMENT_INPUT_COMPONENTS                              = 0x9125
+	MAX_FRAGMENT_INTERPOLATION_OFFSET                          = 0x8e5c
+	MAX_FRAGMENT_SHADER_STORAGE_BLOCKS                         = 0x90da
+	MAX_FRAGMENT_UNIFORM_BLOCKS                                = 0x8a2d
+	MAX_FRAGMENT_UNIFORM_COMPONENTS                            = 0x8b49
+	MAX_FRAGMENT_UNIFORM_VECTORS                               = 0x8dfd
+	MAX_FRAMEBUFFER_HEIGHT                                     = 0x9316
+	MAX_FRAMEBUFFER_LAYERS                                     = 0x9317
+	MAX_FRAMEBUFFER_SAMPLES                                    = 0x9318
+	MAX_FRAMEBUFFER_WIDTH                                      = 0x9315
+	MAX_GEOMETRY_ATOMIC_COUNTER_BUFFERS                        = 0x92cf
+	MAX_GEOMETRY_ATOMIC_COUNTERS                               = 0x92d5
+	MAX_GEOMETRY_IMAGE_UNIFORMS                                = 0x90cd
+	MAX_GEOMETRY_INPUT_COMPONENTS                              = 0x9123
+	MAX_GEOMETRY_OUTPUT_COMPONENTS                             = 0x9124
+	MAX_GEOMETRY_OUTPUT_VERTICES                               = 0x8de0
+	MAX_GEOMETRY_SHADER_INVOCATIONS                            = 0x8e5a
+	MAX_GEOMETRY_SHADER_STORAGE_BLOCKS                         = 0x90d7
+	MAX_GEOMETRY_TEXTURE_IMAGE_UNITS                           = 0x8c29
+	MAX_GEOMETRY_TOTAL_OUTPUT_COMPONENTS                       = 0x8de1
+	MAX_GEOMETRY_UNIFORM_BLOCKS                                = 0x8a2c
+	MAX_GEOMETRY_UNIFORM_COMPONENTS                            = 0x8ddf
+	MAX_HEIGHT                                                 = 0x827f
+	MAX_IMAGE_SAMPLES                                          = 0x906d
+	MAX_IMAGE_UNITS                                            = 0x8f38
+	MAX_INTEGER_SAMPLES                                        = 0x9110
+	MAX_LABEL_LENGTH                                           = 0x82e8
+	MAX_LAYERS                                                 = 0x8281
+	MAX_NAME_LENGTH                                            = 0x92f6
+	MAX_NUM_ACTIVE_VARIABLES                                   = 0x92f7
+	MAX_NUM_COMPATIBLE_SUBROUTINES                             = 0x92f8
+	MAX_PATCH_VERTICES                                         = 0x8e7d
+	MAX_PROGRAM_TEXEL_OFFSET                                   = 0x8905
+	MAX_PROGRAM_TEXTURE_GATHER_OFFSET                          = 0x8e5f
+	MAX_RECTANGLE_TEXTURE_SIZE                                 = 0x84f8
+	MAX_RENDERBUFFER_SIZE                                      = 0x84e8
+	MAX_SAMPLE_MASK_WORDS                                      = 0x8e59
+	MAX_SAMPLES                                                = 0x8d57
+	MAX_SERVER_WAIT_TIMEOUT                                    = 0x9111
+	MAX_SHADER_STORAGE_BLOCK_SIZE                              = 0x90de
+	MAX_SHADER_STORAGE_BUFFER_BINDINGS                         = 0x90dd
+	MAX_SUBROUTINE_UNIFORM_LOCATIONS                           = 0x8de8
+	MAX_SUBROUTINES                                            = 0x8de7
+	MAX_TESS_CONTROL_ATOMIC_COUNTER_BUFFERS                    = 0x92cd
+	MAX_TESS_CONTROL_ATOMIC_COUNTERS                           = 0x92d3
+	MAX_TESS_CONTROL_IMAGE_UNIFORMS                            = 0x90cb
+	MAX_TESS_CONTROL_INPUT_COMPONENTS                          = 0x886c
+	MAX_TESS_CONTROL_OUTPUT_COMPONENTS                         = 0x8e83
+	MAX_TESS_CONTROL_SHADER_STORAGE_BLOCKS                     = 0x90d8
+	MAX_TESS_CONTROL_TEXTURE_IMAGE_UNITS                       = 0x8e81
+	MAX_TESS_CONTROL_TOTAL_OUTPUT_COMPONENTS                   = 0x8e85
+	MAX_TESS_CONTROL_UNIFORM_BLOCKS                            = 0x8e89
+	MAX_TESS_CONTROL_UNIFORM_COMPONENTS                        = 0x8e7f
+	MAX_TESS_EVALUATION_ATOMIC_COUNTER_BUFFERS                 = 0x92ce
+	MAX_TESS_EVALUATION_ATOMIC_COUNTERS                        = 0x92d4
+	MAX_TESS_EVALUATION_IMAGE_UNIFORMS                         = 0x90cc
+	MAX_TESS_EVALUATION_INPUT_COMPONENTS                       = 0x886d
+	MAX_TESS_EVALUATION_OUTPUT_COMPONENTS                      = 0x8e86
+	MAX_TESS_EVALUATION_SHADER_STORAGE_BLOCKS                  = 0x90d9
+	MAX_TESS_EVALUATION_TEXTURE_IMAGE_UNITS                    = 0x8e82
+	MAX_TESS_EVALUATION_UNIFORM_BLOCKS                         = 0x8e8a
+	MAX_TESS_EVALUATION_UNIFORM_COMPONENTS                     = 0x8e80
+	MAX_TESS_GEN_LEVEL                                         = 0x8e7e
+	MAX_TESS_PATCH_COMPONENTS                                  = 0x8e84
+	MAX_TEXTURE_BUFFER_SIZE                                    = 0x8c2b
+	MAX_TEXTURE_IMAGE_UNITS                                    = 0x8872
+	MAX_TEXTURE_LOD_BIAS                                       = 0x84fd
+	MAX_TEXTURE_SIZE                                           = 0xd33
+	MAX_TRANSFORM_FEEDBACK_BUFFERS                             = 0x8e70
+	MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS              = 0x8c8a
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS                    = 0x8c8b
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS                 = 0x8c80
+	MAX_UNIFORM_BLOCK_SIZE                                     = 0x8a30
+	MAX_UNIFORM_BUFFER_BINDINGS                                = 0x8a2f
+	MAX_UNIFORM_LOCATIONS                                      = 0x826e
+	MAX_VARYING_COMPONENTS                                     = 0x8b4b
+	MAX_VARYING_FLOATS                                         = 0x8b4b
+	MAX_VARYING_VECTORS                                        = 0x8dfc
+	MAX_VERTEX_ATOMIC_COUNTER_BUFFERS                          = 0x92cc
+	MAX_VERTEX_ATOMIC_COUNTERS                                 = 0x92d2
+	MAX_VERTEX_ATTRIB_BINDINGS                                 = 0x82da
+	MAX_VERTEX_ATTRIB_RELATIVE_OFFSET                          = 0x82d9
+	MAX_VERTEX_ATTRIBS                                         = 0x8869
+	MAX_VERTEX_IMAGE_UNIFORMS                                  = 0x90ca
+	MAX_VERTEX_OUTPUT_COMPONENTS                               = 0x9122
+	MAX_VERTEX_SHADER_STORAGE_BLOCKS                           = 0x90d6
+	MAX_VERTEX_STREAMS                                         = 0x8e71
+	MAX_VERTEX_TEXTURE_IMAGE_UNITS                             = 0x8b4c
+	MAX_VERTEX_UNIFORM_BLOCKS                                  = 0x8a2b
+	MAX_VERTEX_UNIFORM_COMPONENTS                              = 0x8b4a
+	MAX_VERTEX_UNIFORM_VECTORS                                 = 0x8dfb
+	MAX_VIEWPORT_DIMS                                          = 0xd3a
+	MAX_VIEWPORTS                                              = 0x825b
+	MAX_WIDTH                                                  = 0x827e
+	MEDIUM_FLOAT                                               = 0x8df1
+	MEDIUM_INT                                                 = 0x8df4
+	MIN_FRAGMENT_INTERPOLATION_OFFSET                          = 0x8e5b
+	MIN_MAP_BUFFER_ALIGNMENT                                   = 0x90bc
+	MIN_PROGRAM_TEXEL_OFFSET                                   = 0x8904
+	MIN_PROGRAM_TEXTURE_GATHER_OFFSET                          = 0x8e5e
+	MIN_SAMPLE_SHADING_VALUE                                   = 0x8c37
+	MINOR_VERSION                                              = 0x821c
+	MIPMAP                                                     = 0x8293
+	MIRRORED_REPEAT                                            = 0x8370
+	NAME_LENGTH                                                = 0x92f9
+	NAND                                                       = 0x150e
+	NEAREST_MIPMAP_LINEAR                                      = 0x2702
+	NEAREST_MIPMAP_NEAREST                                     = 0x2700
+	NEAREST                                                    = 0x2600
+	NEGATIVE_ONE_TO_ONE                                        = 0x935e
+	NICEST                                                     = 0x1102
+	NO_ERROR                                                   = 0
+	NONE                                                       = 0
+	NOOP                                                       = 0x1505
+	NOR                                                        = 0x1508
+	NUM_ACTIVE_VARIABLES                                       = 0x9304
+	NUM_COMPATIBLE_SUBROUTINES                                 = 0x8e4a
+	NUM_COMPRESSED_TEXTURE_FORMATS                             = 0x86a2
+	NUM_EXTENSIONS                                             = 0x821d
+	NUM_PROGRAM_BINARY_FORMATS                                 = 0x87fe
+	NUM_SAMPLE_COUNTS                                          = 0x9380
+	NUM_SHADER_BINARY_FORMATS                                  = 0x8df9
+	NUM_SHADING_LANGUAGE_VERSIONS                              = 0x82e9
+	OBJECT_TYPE                                                = 0x9112
+	OFFSET                                                     = 0x92fc
+	ONE_MINUS_SRC1_ALPHA                                       = 0x88fb
+	ONE_MINUS_SRC1_COLOR                                       = 0x88fa
+	ONE                                                        = 0x1
+	OR_INVERTED                                                = 0x150d
+	OR_REVERSE                                                 = 0x150b
+	OR                                                         = 0x1507
+	OUT_OF_MEMORY                                              = 0x505
+	PACK_ALIGNMENT                                             = 0xd05
+	PACK_COMPRESSED_BLOCK_DEPTH                                = 0x912d
+	PACK_COMPRESSED_BLOCK_HEIGHT                               = 0x912c
+	PACK_COMPRESSED_BLOCK_SIZE                                 = 0x912e
+	PACK_COMPRESSED_BLOCK_WIDTH                                = 0x912b
+	PACK_IMAGE_HEIGHT                                          = 0x806c
+	PACK_LSB_FIRST                                             = 0xd01
+	PACK_ROW_LENGTH                                            = 0xd02
+	PACK_SKIP_IMAGES                                           = 0x806b
+	PACK_SKIP_PIXELS                                           = 0xd04
+	PACK_SKIP_ROWS                                             = 0xd03
+	PACK_SWAP_BYTES                                            = 0xd00
+	PATCH_DEFAULT_INNER_LEVEL                                  = 0x8e73
+	PATCH_DEFAULT_OUTER_LEVEL                                  = 0x8e74
+	PATCH_VERTICES                                             = 0x8e72
+	PIXEL_BUFFER_BARRIER_BIT                                   = 0x80
+	PIXEL_PACK_BUFFER_BINDING                                  = 0x88ed
+	PIXEL_UNPACK_BUFFER_BINDING                                = 0x88ef
+	POINT_FADE_THRESHOLD_SIZE                                  = 0x8128
+	POINT_SIZE_GRANULARITY                                     = 0xb13
+	POINT_SIZE_RANGE                                           = 0xb12
+	POINT_SIZE                                                 = 0xb11
+	POINT_SPRITE_COORD_ORIGIN                                  = 0x8ca0
+	POINT                                                      = 0x1b00
+	POLYGON_MODE                                               = 0xb40
+	POLYGON_OFFSET_FACTOR                                      = 0x8038
+	POLYGON_OFFSET_UNITS                                       = 0x2a00
+	POLYGON_SMOOTH_HINT                                        = 0xc53
+	PRIMITIVE_RESTART_INDEX                                    = 0x8f9e
+	PRIMITIVES_GENERATED                                       = 0x8c87
+	PROGRAM_BINARY_FORMATS                                     = 0x87ff
+	PROGRAM_BINARY_LENGTH                                      = 0x8741
+	PROGRAM_BINARY_RETRIEVABLE_HINT                            = 0x8257
+	PROGRAM_INPUT                                              = 0x92e3
+	PROGRAM_OUTPUT                                             = 0x92e4
+	PROGRAM_PIPELINE_BINDING                                   = 0x825a
+	PROGRAM_PIPELINE                                           = 0x82e4
+	PROGRAM_SEPARABLE                                          = 0x8258
+	PROGRAM                                                    = 0x82e2
+	PROVOKING_VERTEX                                           = 0x8e4f
+	PROXY_TEXTURE_1D_ARRAY                                     = 0x8c19
+	PROXY_TEXTURE_1D                                           = 0x8063
+	PROXY_TEXTURE_2D_ARRAY                                     = 0x8c1b
+	PROXY_TEXTURE_2D_MULTISAMPLE_ARRAY                         = 0x9103
+	PROXY_TEXTURE_2D_MULTISAMPLE                               = 0x9101
+	PROXY_TEXTURE_2D                                           = 0x8064
+	PROXY_TEXTURE_3D                                           = 0x8070
+	PROXY_TEXTURE_CUBE_MAP_ARRAY                               = 0x900b
+	PROXY_TEXTURE_CUBE_MAP                                     = 0x851b
+	PROXY_TEXTURE_RECTANGLE                                    = 0x84f7
+	QUADS_FOLLOW_PROVOKING_VERTEX_CONVENTION                   = 0x8e4c
+	QUADS                                                      = 0x7
+	QUERY_BY_REGION_NO_WAIT                                    = 0x8e16
+	QUERY_BY_REGION_WAIT                                       = 0x8e15
+	QUERY_COUNTER_BITS                                         = 0x8864
+	QUERY_NO_WAIT                                              = 0x8e14
+	QUERY_RESULT_AVAILABLE                                     = 0x8867
+	QUERY_RESULT                                               = 0x8866
+	QUERY_WAIT                                                 = 0x8e13
+	QUERY                                                      = 0x82e3
+	R11F_G11F_B10F                                             = 0x8c3a
+	R16_SNORM                                                  = 0x8f98
+	R16F                                                       = 0x822d
+	R16I                                                       = 0x8233
+	R16UI                                                      = 0x8234
+	R16                                                        = 0x822a
+	R3_G3_B2                                                   = 0x2a10
+	R32F                                                       = 0x822e
+	R32I                                                       = 0x8235
+	R32UI                                                      = 0x8236
+	R8_SNORM                                                   = 0x8f94
+	R8I                                                        = 0x8231
+	R8UI                                                       = 0x8232
+	R8                                                         = 0x8229
+	READ_BUFFER                                                = 0xc02
+	READ_FRAMEBUFFER_BINDING                                   = 0x8caa
+	READ_ONLY                                                  = 0x88b8
+	READ_PIXELS_FORMAT                                         = 0x828d
+	READ_PIXELS_TYPE                                           = 0x828e
+	READ_PIXELS                                                = 0x828c
+	READ_WRITE                                                 = 0x88ba
+	RED_INTEGER                                                = 0x8d94
+	RED                                                        = 0x1903
+	REFERENCED_BY_COMPUTE_SHADER                               = 0x930b
+	REFERENCED_BY_FRAGMENT_SHADER                              = 0x930a
+	REFERENCED_BY_GEOMETRY_SHADER                              = 0x9309
+	REFERENCED_BY_TESS_CONTROL_SHADER                          = 0x9307
+	REFERENCED_BY_TESS_EVALUATION_SHADER                       = 0x9308
+	REFERENCED_BY_VERTEX_SHADER                                = 0x9306
+	RENDERBUFFER_ALPHA_SIZE                                    = 0x8d53
+	RENDERBUFFER_BINDING                                       = 0x8ca7
+	RENDERBUFFER_BLUE_SIZE                                     = 0x8d52
+	RENDERBUFFER_DEPTH_SIZE                                    = 0x8d54
+	RENDERBUFFER_GREEN_SIZE                                    = 0x8d51
+	RENDERBUFFER_HEIGHT                                        = 0x8d43
+	RENDERBUFFER_INTERNAL_FORMAT                               = 0x8d44
+	RENDERBUFFER_RED_SIZE                                      = 0x8d50
+	RENDERBUFFER_SAMPLES                                       = 0x8cab
+	RENDERBUFFER_STENCIL_SIZE                                  = 0x8d55
+	RENDERBUFFER_WIDTH                                         = 0x8d42
+	RENDERBUFFER                                               = 0x8d41
+	RENDERER                                                   = 0x1f01
+	REPEAT                                                     = 0x2901
+	RG_INTEGER                                                 = 0x8228
+	RG16_SNORM                                                 = 0x8f99
+	RG16F                                                      = 0x822f
+	RG16I                                                      = 0x8239
+	RG16UI                                                     = 0x823a
+	RG16                                                       = 0x822c
+	RG32F                                                      = 0x8230
+	RG32I                                                      = 0x823b
+	RG32UI                                                     = 0x823c
+	RG8_SNORM                                                  = 0x8f95
+	RG8I                                                       = 0x8237
+	RG8UI                                                      = 0x8238
+	RG8                                                        = 0x822b
+	RGB_INTEGER                                                = 0x8d98
+	RGB10_A2UI                                                 = 0x906f
+	RGB10_A2                                                   = 0x8059
+	RGB10                                                      = 0x8052
+	RGB12                                                      = 0x8053
+	RGB16_SNORM                                                = 0x8f9a
+	RGB16F                                                     = 0x881b
+	RGB16I                                                     = 0x8d89
+	RGB16UI                                                    = 0x8d77
+	RGB16                                                      = 0x8054
+	RGB32F                                                     = 0x8815
+	RGB32I                                                     = 0x8d83
+	RGB32UI                                                    = 0x8d71
+	RGB4                                                       = 0x804f
+	RGB5_A1                                                    = 0x8057
+	RGB565                                                     = 0x8d62
+	RGB5                                                       = 0x8050
+	RGB8_SNORM                                                 = 0x8f96
+	RGB8I                                                      = 0x8d8f
+	RGB8UI                                                     = 0x8d7d
+	RGB8                                                       = 0x8051
+	RGB9_E5                                                    = 0x8c3d
+	RGBA_INTEGER                                               = 0x8d99
+	RGBA12                                                     = 0x805a
+	RGBA16_SNORM                                               = 0x8f9b
+	RGBA16F                                                    = 0x881a
+	RGBA16I                                                    = 0x8d88
+	RGBA16UI                                                   = 0x8d76
+	RGBA16                                                     = 0x805b
+	RGBA2                                                      = 0x8055
+	RGBA32F                                                    = 0x8814
+	RGBA32I                                                    = 0x8d82
+	RGBA32UI                                                   = 0x8d70
+	RGBA4                                                      = 0x8056
+	RGBA8_SNORM                                                = 0x8f97
+	RGBA8I                                                     = 0x8d8e
+	RGBA8UI                                                    = 0x8d7c
+	RGBA8                                                      = 0x8058
+	RGBA                                                       = 0x1908
+	RGB                                                        = 0x1907
+	RG                                                         = 0x8227
+	RIGHT                                                      = 0x407
+	SAMPLE_BUFFERS                                             = 0x80a8
+	SAMPLE_COVERAGE_INVERT                                     = 0x80ab
+	SAMPLE_COVERAGE_VALUE                                      = 0x80aa
+	SAMPLE_MASK_VALUE                                          = 0x8e52
+	SAMPLE_POSITION                                            = 0x8e50
+	SAMPLER_1D_ARRAY_SHADOW                                    = 0x8dc3
+	SAMPLER_1D_ARRAY                                           = 0x8dc0
+	SAMPLER_1D_SHADOW                                          = 0x8b61
+	SAMPLER_1D                                                 = 0x8b5d
+	SAMPLER_2D_ARRAY_SHADOW                                    = 0x8dc4
+	SAMPLER_2D_ARRAY                                           = 0x8dc1
+	SAMPLER_2D_MULTISAMPLE_ARRAY                               = 0x910b
+	SAMPLER_2D_MULTISAMPLE                                     = 0x9108
+	SAMPLER_2D_RECT_SHADOW                                     = 0x8b64
+	SAMPLER_2D_RECT                                            = 0x8b63
+	SAMPLER_2D_SHADOW                                          = 0x8b62
+	SAMPLER_2D                                                 = 0x8b5e
+	SAMPLER_3D                                                 = 0x8b5f
+	SAMPLER_BINDING                                            = 0x8919
+	SAMPLER_BUFFER                                             = 0x8dc2
+	SAMPLER_CUBE_MAP_ARRAY_SHADOW                              = 0x900d
+	SAMPLER_CUBE_MAP_ARRAY                                     = 0x900c
+	SAMPLER_CUBE_SHADOW                                        = 0x8dc5
+	SAMPLER_CUBE                                               = 0x8b60
+	SAMPLER                                                    = 0x82e6
+	SAMPLES_PASSED                                             = 0x8914
+	SAMPLES                                                    = 0x80a9
+	SCISSOR_BOX                                                = 0xc10
+	SEPARATE_ATTRIBS                                           = 0x8c8d
+	SET                                                        = 0x150f
+	SHADER_BINARY_FORMATS                                      = 0x8df8
+	SHADER_COMPILER                                            = 0x8dfa
+	SHADER_IMAGE_ACCESS_BARRIER_BIT                            = 0x20
+	SHADER_IMAGE_ATOMIC                                        = 0x82a6
+	SHADER_IMAGE_LOAD                                          = 0x82a4
+	SHADER_IMAGE_STORE                                         = 0x82a5
+	SHADER_SOURCE_LENGTH                                       = 0x8b88
+	SHADER_STORAGE_BARRIER_BIT                                 = 0x2000
+	SHADER_STORAGE_BLOCK                                       = 0x92e6
+	SHADER_STORAGE_BUFFER_BINDING                              = 0x90d3
+	SHADER_STORAGE_BUFFER_OFFSET_ALIGNMENT                     = 0x90df
+	SHADER_STORAGE_BUFFER_SIZE                                 = 0x90d5
+	SHADER_STORAGE_BUFFER_START                                = 0x90d4
+	SHADER_TYPE                                                = 0x8b4f
+	SHADER                                                     = 0x82e1
+	SHADING_LANGUAGE_VERSION                                   = 0x8b8c
+	SHORT                                                      = 0x1402
+	SIGNALED                                                   = 0x9119
+	SIGNED_NORMALIZED                                          = 0x8f9c
+	SIMULTANEOUS_TEXTURE_AND_DEPTH_TEST                        = 0x82ac
+	SIMULTANEOUS_TEXTURE_AND_DEPTH_WRITE                       = 0x82ae
+	SIMULTANEOUS_TEXTURE_AND_STENCIL_TEST                      = 0x82ad
+	SIMULTANEOUS_TEXTURE_AND_STENCIL_WRITE                     = 0x82af
+	SMOOTH_LINE_WIDTH_GRANULARITY                              = 0xb23
+	SMOOTH_LINE_WIDTH_RANGE                                    = 0xb22
+	SMOOTH_POINT_SIZE_GRANULARITY                              = 0xb13
+	SMOOTH_POINT_SIZE_RANGE                                    = 0xb12
+	SRC1_ALPHA                                                 = 0x8589
+	SRC1_COLOR                                                 = 0x88f9
+	SRGB_ALPHA                                                 = 0x8c42
+	SRGB_READ                                                  = 0x8297
+	SRGB_WRITE                                                 = 0x8298
+	SRGB8_ALPHA8                                               = 0x8c43
+	SRGB8                                                      = 0x8c41
+	SRGB                                                       = 0x8c40
+	STACK_OVERFLOW                                             = 0x503
+	STACK_UNDERFLOW                                            = 0x504
+	STENCIL_ATTACHMENT                                         = 0x8d20
+	STENCIL_BACK_FAIL                                          = 0x8801
+	STENCIL_BACK_FUNC                                          = 0x8800
+	STENCIL_BACK_PASS_DEPTH_FAIL                               = 0x8802
+	STENCIL_BACK_PASS_DEPTH_PASS                               = 0x8803
+	STENCIL_BACK_REF                                           = 0x8ca3
+	STENCIL_BACK_VALUE_MASK                                    = 0x8ca4
+	STENCIL_BACK_WRITEMASK                                     = 0x8ca5
+	STENCIL_CLEAR_VALUE                                        = 0xb91
+	STENCIL_COMPONENTS                                         = 0x8285
+	STENCIL_FAIL                                               = 0xb94
+	STENCIL_FUNC                                               = 0xb92
+	STENCIL_INDEX16                                            = 0x8d49
+	STENCIL_INDEX1                                             = 0x8d46
+	STENCIL_INDEX4                                             = 0x8d47
+	STENCIL_INDEX8                                             = 0x8d48
+	STENCIL_INDEX                                              = 0x1901
+	STENCIL_PASS_DEPTH_FAIL                                    = 0xb95
+	STENCIL_PASS_DEPTH_PASS                                    = 0xb96
+	STENCIL_REF                                                = 0xb97
+	STENCIL_RENDERABLE                                         = 0x8288
+	STENCIL_VALUE_MASK                                         = 0xb93
+	STENCIL_WRITEMASK                                          = 0xb98
+	STENCIL                                                    = 0x1802
+	STEREO                                                     = 0xc33
+	SUBPIXEL_BITS                                              = 0xd50
+	SYNC_CONDITION                                             = 0x9113
+	SYNC_FENCE                                                 = 0x9116
+	SYNC_FLAGS                                                 = 0x9115
+	SYNC_FLUSH_COMMANDS_BIT                                    = 0x1
+	SYNC_GPU_COMMANDS_COMPLETE                                 = 0x9117
+	SYNC_STATUS                                                = 0x9114
+	TESS_CONTROL_OUTPUT_VERTICES                               = 0x8e75
+	TESS_CONTROL_SHADER_BIT                                    = 0x8
+	TESS_CONTROL_SUBROUTINE_UNIFORM                            = 0x92ef
+	TESS_CONTROL_SUBROUTINE                                    = 0x92e9
+	TESS_CONTROL_TEXTURE                                       = 0x829c
+	TESS_EVALUATION_SHADER_BIT                                 = 0x10
+	TESS_EVALUATION_SUBROUTINE_UNIFORM                         = 0x92f0
+	TESS_EVALUATION_SUBROUTINE                                 = 0x92ea
+	TESS_EVALUATION_TEXTURE                                    = 0x829d
+	TESS_GEN_MODE                                              = 0x8e76
+	TESS_GEN_POINT_MODE                                        = 0x8e79
+	TESS_GEN_SPACING                                           = 0x8e77
+	TESS_GEN_VERTEX_ORDER                                      = 0x8e78
+	TEXTURE_ALPHA_SIZE                                         = 0x805f
+	TEXTURE_ALPHA_TYPE                                         = 0x8c13
+	TEXTURE_BASE_LEVEL                                         = 0x813c
+	TEXTURE_BINDING_1D_ARRAY                                   = 0x8c1c
+	TEXTURE_BINDING_1D                                         = 0x8068
+	TEXTURE_BINDING_2D_ARRAY                                   = 0x8c1d
+	TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY                       = 0x9105
+	TEXTURE_BINDING_2D_MULTISAMPLE                             = 0x9104
+	TEXTURE_BINDING_2D                                         = 0x8069
+	TEXTURE_BINDING_3D                                         = 0x806a
+	TEXTURE_BINDING_BUFFER                                     = 0x8c2c
+	TEXTURE_BINDING_CUBE_MAP_ARRAY                             = 0x900a
+	TEXTURE_BINDING_CUBE_MAP                                   = 0x8514
+	TEXTURE_BINDING_RECTANGLE                                  = 0x84f6
+	TEXTURE_BLUE_SIZE                                          = 0x805e
+	TEXTURE_BLUE_TYPE                                          = 0x8c12
+	TEXTURE_BORDER_COLOR                                       = 0x1004
+	TEXTURE_BUFFER_DATA_STORE_BINDING                          = 0x8c2d
+	TEXTURE_BUFFER_OFFSET_ALIGNMENT                            = 0x919f
+	TEXTURE_BUFFER_OFFSET                                      = 0x919d
+	TEXTURE_BUFFER_SIZE                                        = 0x919e
+	TEXTURE_BUFFER                                             = 0x8c2a
+	TEXTURE_COMPARE_FUNC                                       = 0x884d
+	TEXTURE_COMPARE_MODE                                       = 0x884c
+	TEXTURE_COMPRESSED_BLOCK_HEIGHT                            = 0x82b2
+	TEXTURE_COMPRESSED_BLOCK_SIZE                              = 0x82b3
+	TEXTURE_COMPRESSED_BLOCK_WIDTH                             = 0x82b1
+	TEXTURE_COMPRESSED_IMAGE_SIZE                              = 0x86a0
+	TEXTURE_COMPRESSED                                         = 0x86a1
+	TEXTURE_COMPRESSION_HINT                                   = 0x84ef
+	TEXTURE_DEPTH_SIZE                                         = 0x884a
+	TEXTURE_DEPTH_TYPE                                         = 0x8c16
+	TEXTURE_DEPTH                                              = 0x8071
+	TEXTURE_FETCH_BARRIER_BIT                                  = 0x8
+	TEXTURE_FIXED_SAMPLE_LOCATIONS                             = 0x9107
+	TEXTURE_GATHER_SHADOW                                      = 0x82a3
+	TEXTURE_GATHER                                             = 0x82a2
+	TEXTURE_GREEN_SIZE                                         = 0x805d
+	TEXTURE_GREEN_TYPE                                         = 0x8c11
+	TEXTURE_HEIGHT                                             = 0x1001
+	TEXTURE_IMAGE_FORMAT                                       = 0x828f
+	TEXTURE_IMAGE_TYPE                                         = 0x8290
+	TEXTURE_IMMUTABLE_FORMAT                                   = 0x912f
+	TEXTURE_IMMUTABLE_LEVELS                                   = 0x82df
+	TEXTURE_INTERNAL_FORMAT                                    = 0x1003
+	TEXTURE_LOD_BIAS                                           = 0x8501
+	TEXTURE_MAG_FILTER                                         = 0x2800
+	TEXTURE_MAX_LEVEL                                          = 0x813d
+	TEXTURE_MAX_LOD                                            = 0x813b
+	TEXTURE_MIN_FILTER                                         = 0x2801
+	TEXTURE_MIN_LOD                                            = 0x813a
+	TEXTURE_RED_SIZE                                           = 0x805c
+	TEXTURE_RED_TYPE                                           = 0x8c10
+	TEXTURE_SAMPLES                                            = 0x9106
+	TEXTURE_SHADOW                                             = 0x82a1
+	TEXTURE_SHARED_SIZE                                        = 0x8c3f
+	TEXTURE_STENCIL_SIZE                                       = 0x88f1
+	TEXTURE_SWIZZLE_A                                          = 0x8e45
+	TEXTURE_SWIZZLE_B                                          = 0x8e44
+	TEXTURE_SWIZZLE_G                                          = 0x8e43
+	TEXTURE_SWIZZLE_RGBA                                       = 0x8e46
+	TEXTURE_SWIZZLE_R                                          = 0x8e42
+	TEXTURE_UPDATE_BARRIER_BIT                                 = 0x100
+	TEXTURE_VIEW_MIN_LAYER                                     = 0x82dd
+	TEXTURE_VIEW_MIN_LEVEL                                     = 0x82db
+	TEXTURE_VIEW_NUM_LAYERS                                    = 0x82de
+	TEXTURE_VIEW_NUM_LEVELS                                    = 0x82dc
+	TEXTURE_VIEW                                               = 0x82b5
+	TEXTURE_WIDTH                                              = 0x1000
+	TEXTURE_WRAP_R                                             = 0x8072
+	TEXTURE_WRAP_S                                             = 0x2802
+	TEXTURE_WRAP_T                                             = 0x2803
+	TEXTURE0                                                   = 0x84c0
+	TEXTURE10                                                  = 0x84ca
+	TEXTURE11                                                  = 0x84cb
+	TEXTURE12                                                  = 0x84cc
+	TEXTURE13                                                  = 0x84cd
+	TEXTURE14                                                  = 0x84ce
+	TEXTURE15                                                  = 0x84cf
+	TEXTURE16                                                  = 0x84d0
+	TEXTURE17                                                  = 0x84d1
+	TEXTURE18                                                  = 0x84d2
+	TEXTURE19                                                  = 0x84d3
+	TEXTURE1                                                   = 0x84c1
+	TEXTURE20                                                  = 0x84d4
+	TEXTURE21                                                  = 0x84d5
+	TEXTURE22                                                  = 0x84d6
+	TEXTURE23                                                  = 0x84d7
+	TEXTURE24                                                  = 0x84d8
+	TEXTURE25                                                  = 0x84d9
+	TEXTURE26                                                  = 0x84da
+	TEXTURE27                                                  = 0x84db
+	TEXTURE28                                                  = 0x84dc
+	TEXTURE29                                                  = 0x84dd
+	TEXTURE2                                                   = 0x84c2
+	TEXTURE30                                                  = 0x84de
+	TEXTURE31                                                  = 0x84df
+	TEXTURE3                                                   = 0x84c3
+	TEXTURE4                                                   = 0x84c4
+	TEXTURE5                                                   = 0x84c5
+	TEXTURE6                                                   = 0x84c6
+	TEXTURE7                                                   = 0x84c7
+	TEXTURE8                                                   = 0x84c8
+	TEXTURE9                                                   = 0x84c9
+	TEXTURE                                                    = 0x1702
+	TIME_ELAPSED                                               = 0x88bf
+	TIMEOUT_EXPIRED                                            = 0x911b
+	TIMEOUT_IGNORED                                            = 0xffffffffffffffff
+	TIMESTAMP                                                  = 0x8e28
+	TOP_LEVEL_ARRAY_SIZE                                       = 0x930c
+	TOP_LEVEL_ARRAY_STRIDE                                     = 0x930d
+	TRANSFORM_FEEDBACK_ACTIVE                                  = 0x8e24
+	TRANSFORM_FEEDBACK_BARRIER_BIT                             = 0x800
+	TRANSFORM_FEEDBACK_BINDING                                 = 0x8e25
+	TRANSFORM_FEEDBACK_BUFFER_ACTIVE                           = 0x8e24
+	TRANSFORM_FEEDBACK_BUFFER_BINDING                          = 0x8c8f
+	TRANSFORM_FEEDBACK_BUFFER_MODE                             = 0x8c7f
+	TRANSFORM_FEEDBACK_BUFFER_PAUSED                           = 0x8e23
+	TRANSFORM_FEEDBACK_BUFFER_SIZE                             = 0x8c85
+	TRANSFORM_FEEDBACK_BUFFER_START                            = 0x8c84
+	TRANSFORM_FEEDBACK_PAUSED                                  = 0x8e23
+	TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN                      = 0x8c88
+	TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH                      = 0x8c76
+	TRANSFORM_FEEDBACK_VARYINGS                                = 0x8c83
+	TRANSFORM_FEEDBACK_VARYING                                 = 0x92f4
+	TRANSFORM_FEEDBACK                                         = 0x8e22
+	TRUE                                                       = 0x1
+	TYPE                                                       = 0x92fa
+	UNDEFINED_VERTEX                                           = 0x8260
+	UNIFORM_ARRAY_STRIDE                                       = 0x8a3c
+	UNIFORM_ATOMIC_COUNTER_BUFFER_INDEX                        = 0x92da
+	UNIFORM_BARRIER_BIT                                        = 0x4
+	UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES                       = 0x8a43
+	UNIFORM_BLOCK_ACTIVE_UNIFORMS                              = 0x8a42
+	UNIFORM_BLOCK_BINDING                                      = 0x8a3f
+	UNIFORM_BLOCK_DATA_SIZE                                    = 0x8a40
+	UNIFORM_BLOCK_INDEX                                        = 0x8a3a
+	UNIFORM_BLOCK_NAME_LENGTH                                  = 0x8a41
+	UNIFORM_BLOCK_REFERENCED_BY_COMPUTE_SHADER                 = 0x90ec
+	UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER                = 0x8a46
+	UNIFORM_BLOCK_REFERENCED_BY_GEOMETRY_SHADER                = 0x8a45
+	UNIFORM_BLOCK_REFERENCED_BY_TESS_CONTROL_SHADER            = 0x84f0
+	UNIFORM_BLOCK_REFERENCED_BY_TESS_EVALUATION_SHADER         = 0x84f1
+	UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER                  = 0x8a44
+	UNIFORM_BLOCK                                              = 0x92e2
+	UNIFORM_BUFFER_BINDING                                     = 0x8a28
+	UNIFORM_BUFFER_OFFSET_ALIGNMENT                            = 0x8a34
+	UNIFORM_BUFFER_SIZE                                        = 0x8a2a
+	UNIFORM_BUFFER_START                                       = 0x8a29
+	UNIFORM_IS_ROW_MAJOR                                       = 0x8a3e
+	UNIFORM_MATRIX_STRIDE                                      = 0x8a3d
+	UNIFORM_NAME_LENGTH                                        = 0x8a39
+	UNIFORM_OFFSET                                             = 0x8a3b
+	UNIFORM_SIZE                                               = 0x8a38
+	UNIFORM_TYPE                                               = 0x8a37
+	UNIFORM                                                    = 0x92e1
+	UNPACK_ALIGNMENT                                           = 0xcf5
+	UNPACK_COMPRESSED_BLOCK_DEPTH                              = 0x9129
+	UNPACK_COMPRESSED_BLOCK_HEIGHT                             = 0x9128
+	UNPACK_COMPRESSED_BLOCK_SIZE                               = 0x912a
+	UNPACK_COMPRESSED_BLOCK_WIDTH                              = 0x9127
+	UNPACK_IMAGE_HEIGHT                                        = 0x806e
+	UNPACK_LSB_FIRST                                           = 0xcf1
+	UNPACK_ROW_LENGTH                                          = 0xcf2
+	UNPACK_SKIP_IMAGES                                         = 0x806d
+	UNPACK_SKIP_PIXELS                                         = 0xcf4
+	UNPACK_SKIP_ROWS                                           = 0xcf3
+	UNPACK_SWAP_BYTES                                          = 0xcf0
+	UNSIGNALED                                                 = 0x9118
+	UNSIGNED_BYTE_2_3_3_REV                                    = 0x8362
+	UNSIGNED_BYTE_3_3_2                                        = 0x8032
+	UNSIGNED_BYTE                                              = 0x1401
+	UNSIGNED_INT_10_10_10_2                                    = 0x8036
+	UNSIGNED_INT_10F_11F_11F_REV                               = 0x8c3b
+	UNSIGNED_INT_2_10_10_10_REV                                = 0x8368
+	UNSIGNED_INT_24_8                                          = 0x84fa
+	UNSIGNED_INT_5_9_9_9_REV                                   = 0x8c3e
+	UNSIGNED_INT_8_8_8_8_REV                                   = 0x8367
+	UNSIGNED_INT_8_8_8_8                                       = 0x8035
+	UNSIGNED_INT_ATOMIC_COUNTER                                = 0x92db
+	UNSIGNED_INT_IMAGE_1D_ARRAY                                = 0x9068
+	UNSIGNED_INT_IMAGE_1D                                      = 0x9062
+	UNSIGNED_INT_IMAGE_2D_ARRAY                                = 0x9069
+	UNSIGNED_INT_IMAGE_2D_MULTISAMPLE_ARRAY                    = 0x906c
+	UNSIGNED_INT_IMAGE_2D_MULTISAMPLE                          = 0x906b
+	UNSIGNED_INT_IMAGE_2D_RECT                                 = 0x9065
+	UNSIGNED_INT_IMAGE_2D                                      = 0x9063
+	UNSIGNED_INT_IMAGE_3D                                      = 0x9064
+	UNSIGNED_INT_IMAGE_BUFFER                                  = 0x9067
+	UNSIGNED_INT_IMAGE_CUBE_MAP_ARRAY                          = 0x906a
+	UNSIGNED_INT_IMAGE_CUBE                                    = 0x9066
+	UNSIGNED_INT_SAMPLER_1D_ARRAY                              = 0x8dd6
+	UNSIGNED_INT_SAMPLER_1D                                    = 0x8dd1
+	UNSIGNED_INT_SAMPLER_2D_ARRAY                              = 0x8dd7
+	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE_ARRAY                  = 0x910d
+	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE                        = 0x910a
+	UNSIGNED_INT_SAMPLER_2D_RECT                               = 0x8dd5
+	UNSIGNED_INT_SAMPLER_2D                                    = 0x8dd2
+	UNSIGNED_INT_SAMPLER_3D                                    = 0x8dd3
+	UNSIGNED_INT_SAMPLER_BUFFER                                = 0x8dd8
+	UNSIGNED_INT_SAMPLER_CUBE_MAP_ARRAY                        = 0x900f
+	UNSIGNED_INT_SAMPLER_CUBE                                  = 0x8dd4
+	UNSIGNED_INT_VEC2                                          = 0x8dc6
+	UNSIGNED_INT_VEC3                                          = 0x8dc7
+	UNSIGNED_INT_VEC4                                          = 0x8dc8
+	UNSIGNED_INT                                               = 0x1405
+	UNSIGNED_NORMALIZED                                        = 0x8c17
+	UNSIGNED_SHORT_1_5_5_5_REV                                 = 0x8366
+	UNSIGNED_SHORT_4_4_4_4_REV                                 = 0x8365
+	UNSIGNED_SHORT_4_4_4_4                                     = 0x8033
+	UNSIGNED_SHORT_5_5_5_1                                     = 0x8034
+	UNSIGNED_SHORT_5_6_5_REV                                   = 0x8364
+	UNSIGNED_SHORT_5_6_5                                       = 0x8363
+	UNSIGNED_SHORT                                             = 0x1403
+	UPPER_LEFT                                                 = 0x8ca2
+	VALIDATE_STATUS                                            = 0x8b83
+	VENDOR                                                     = 0x1f00
+	VERSION                                                    = 0x1f02
+	VERTEX_ARRAY_BINDING                                       = 0x85b5
+	VERTEX_ARRAY                                               = 0x8074
+	VERTEX_ATTRIB_ARRAY_BARRIER_BIT                            = 0x1
+	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING                         = 0x889f
+	VERTEX_ATTRIB_ARRAY_DIVISOR                                = 0x88fe
+	VERTEX_ATTRIB_ARRAY_ENABLED                                = 0x8622
+	VERTEX_ATTRIB_ARRAY_INTEGER                                = 0x88fd
+	VERTEX_ATTRIB_ARRAY_LONG                                   = 0x874e
+	VERTEX_ATTRIB_ARRAY_NORMALIZED                             = 0x886a
+	VERTEX_ATTRIB_ARRAY_POINTER                                = 0x8645
+	VERTEX_ATTRIB_ARRAY_SIZE                                   = 0x8623
+	VERTEX_ATTRIB_ARRAY_STRIDE                                 = 0x8624
+	VERTEX_ATTRIB_ARRAY_TYPE                                   = 0x8625
+	VERTEX_ATTRIB_BINDING                                      = 0x82d4
+	VERTEX_ATTRIB_RELATIVE_OFFSET                              = 0x82d5
+	VERTEX_BINDING_BUFFER                                      = 0x8f4f
+	VERTEX_BINDING_DIVISOR                                     = 0x82d6
+	VERTEX_BINDING_OFFSET                                      = 0x82d7
+	VERTEX_BINDING_STRIDE                                      = 0x82d8
+	VERTEX_PROGRAM_POINT_SIZE                                  = 0x8642
+	VERTEX_SHADER_BIT                                          = 0x1
+	VERTEX_SUBROUTINE_UNIFORM                                  = 0x92ee
+	VERTEX_SUBROUTINE                                          = 0x92e8
+	VERTEX_TEXTURE                                             = 0x829b
+	VIEW_CLASS_128_BITS                                        = 0x82c4
+	VIEW_CLASS_16_BITS                                         = 0x82ca
+	VIEW_CLASS_24_BITS                                         = 0x82c9
+	VIEW_CLASS_32_BITS                                         = 0x82c8
+	VIEW_CLASS_48_BITS                                         = 0x82c7
+	VIEW_CLASS_64_BITS                                         = 0x82c6
+	VIEW_CLASS_8_BITS                                          = 0x82cb
+	VIEW_CLASS_96_BITS                                         = 0x82c5
+	VIEW_CLASS_BPTC_FLOAT                                      = 0x82d3
+	VIEW_CLASS_BPTC_UNORM                                      = 0x82d2
+	VIEW_CLASS_RGTC1_RED                                       = 0x82d0
+	VIEW_CLASS_RGTC2_RG                                        = 0x82d1
+	VIEW_CLASS_S3TC_DXT1_RGBA                                  = 0x82cd
+	VIEW_CLASS_S3TC_DXT1_RGB                                   = 0x82cc
+	VIEW_CLASS_S3TC_DXT3_RGBA                                  = 0x82ce
+	VIEW_CLASS_S3TC_DXT5_RGBA                                  = 0x82cf
+	VIEW_COMPATIBILITY_CLASS                                   = 0x82b6
+	VIEWPORT_BOUNDS_RANGE                                      = 0x825d
+	VIEWPORT_INDEX_PROVOKING_VERTEX                            = 0x825f
+	VIEWPORT_SUBPIXEL_BITS                                     = 0x825c
+	VIEWPORT                                                   = 0xba2
+	WAIT_FAILED                                                = 0x911d
+	WRITE_ONLY                                                 = 0x88b9
+	XOR                                                        = 0x1506
+	ZERO_TO_ONE                                                = 0x935f
+	ZERO                                                       = 0
+)
+
+const (
+	LINE_SMOOTH                   Capability = 0xb20
+	POLYGON_SMOOTH                Capability = 0xb41
+	CULL_FACE                     Capability = 0xb44
+	DEPTH_TEST                    Capability = 0xb71
+	STENCIL_TEST                  Capability = 0xb90
+	DITHER                        Capability = 0xbd0
+	BLEND                         Capability = 0xbe2
+	SCISSOR_TEST                  Capability = 0xc11
+	COLOR_LOGIC_OP                Capability = 0xbf2
+	POLYGON_OFFSET_POINT          Capability = 0x2a01
+	POLYGON_OFFSET_LINE           Capability = 0x2a02
+	POLYGON_OFFSET_FILL           Capability = 0x8037
+	MULTISAMPLE                   Capability = 0x809d
+	SAMPLE_ALPHA_TO_COVERAGE      Capability = 0x809e
+	SAMPLE_ALPHA_TO_ONE           Capability = 0x809f
+	SAMPLE_COVERAGE               Capability = 0x80a0
+	RASTERIZER_DISCARD            Capability = 0x8c89
+	FRAMEBUFFER_SRGB              Capability = 0x8db9
+	PRIMITIVE_RESTART             Capability = 0x8f9d
+	PROGRAM_POINT_SIZE            Capability = 0x8642
+	DEPTH_CLAMP                   Capability = 0x864f
+	TEXTURE_CUBE_MAP_SEAMLESS     Capability = 0x884f
+	SAMPLE_MASK                   Capability = 0x8e51
+	SAMPLE_SHADING                Capability = 0x8c36
+	PRIMITIVE_RESTART_FIXED_INDEX Capability = 0x8d69
+	DEBUG_OUTPUT_SYNCHRONOUS      Capability = 0x8242
+	DEBUG_OUTPUT                  Capability = 0x92e0
+)
+
+const (
+	SRC_COLOR                BlendFactor = 0x300
+	ONE_MINUS_SRC_COLOR      BlendFactor = 0x301
+	SRC_ALPHA                BlendFactor = 0x302
+	ONE_MINUS_SRC_ALPHA      BlendFactor = 0x303
+	DST_ALPHA                BlendFactor = 0x304
+	ONE_MINUS_DST_ALPHA      BlendFactor = 0x305
+	DST_COLOR                BlendFactor = 0x306
+	ONE_MINUS_DST_COLOR      BlendFactor = 0x307
+	SRC_ALPHA_SATURATE       BlendFactor = 0x308
+	CONSTANT_COLOR           BlendFactor = 0x8001
+	ONE_MINUS_CONSTANT_COLOR BlendFactor = 0x8002
+	CONSTANT_ALPHA           BlendFactor = 0x8003
+	ONE_MINUS_CONSTANT_ALPHA BlendFactor = 0x8004
+)
+
+const (
+	ARRAY_BUFFER              BufferTarget = 0x8892
+	ELEMENT_ARRAY_BUFFER      BufferTarget = 0x8893
+	PIXEL_PACK_BUFFER         BufferTarget = 0x88eb
+	PIXEL_UNPACK_BUFFER       BufferTarget = 0x88ec
+	TRANSFORM_FEEDBACK_BUFFER BufferTarget = 0x8c8e
+	COPY_READ_BUFFER          BufferTarget = 0x8f36
+	COPY_WRITE_BUFFER         BufferTarget = 0x8f37
+	UNIFORM_BUFFER            BufferTarget = 0x8a11
+	DRAW_INDIRECT_BUFFER      BufferTarget = 0x8f3f
+	ATOMIC_COUNTER_BUFFER     BufferTarget = 0x92c0
+	DISPATCH_INDIRECT_BUFFER  BufferTarget = 0x90ee
+	SHADER_STORAGE_BUFFER     BufferTarget = 0x90d2
+)
+
+const (
+	STREAM_DRAW  BufferUsage = 0x88e0
+	STREAM_READ  BufferUsage = 0x88e1
+	STREAM_COPY  BufferUsage = 0x88e2
+	STATIC_DRAW  BufferUsage = 0x88e4
+	STATIC_READ  BufferUsage = 0x88e5
+	STATIC_COPY  BufferUsage = 0x88e6
+	DYNAMIC_DRAW BufferUsage = 0x88e8
+	DYNAMIC_READ BufferUsage = 0x88e9
+	DYNAMIC_COPY BufferUsage = 0x88ea
+)
+
+const (
+	POINTS                   PrimitiveMode = 0
+	LINES                    PrimitiveMode = 0x1
+	LINE_LOOP                PrimitiveMode = 0x2
+	LINE_STRIP               PrimitiveMode = 0x3
+	TRIANGLES                PrimitiveMode = 0x4
+	TRIANGLE_STRIP           PrimitiveMode = 0x5
+	TRIANGLE_FAN             PrimitiveMode = 0x6
+	LINES_ADJACENCY          PrimitiveMode = 0xa
+	LINE_STRIP_ADJACENCY     PrimitiveMode = 0xb
+	TRIANGLES_ADJACENCY      PrimitiveMode = 0xc
+	TRIANGLE_STRIP_ADJACENCY PrimitiveMode = 0xd
+	PATCHES                  PrimitiveMode = 0xe
+)
+
+const (
+	TEXTURE_1D                   TextureTarget = 0xde0
+	TEXTURE_2D                   TextureTarget = 0xde1
+	TEXTURE_3D                   TextureTarget = 0x806f
+	TEXTURE_CUBE_MAP             TextureTarget = 0x8513
+	TEXTURE_CUBE_MAP_POSITIVE_X  TextureTarget = 0x8515
+	TEXTURE_CUBE_MAP_NEGATIVE_X  TextureTarget = 0x8516
+	TEXTURE_CUBE_MAP_POSITIVE_Y  TextureTarget = 0x8517
+	TEXTURE_CUBE_MAP_NEGATIVE_Y  TextureTarget = 0x8518
+	TEXTURE_CUBE_MAP_POSITIVE_Z  TextureTarget = 0x8519
+	TEXTURE_CUBE_MAP_NEGATIVE_Z  TextureTarget = 0x851a
+	TEXTURE_1D_ARRAY             TextureTarget = 0x8c18
+	TEXTURE_2D_ARRAY             TextureTarget = 0x8c1a
+	TEXTURE_RECTANGLE            TextureTarget = 0x84f5
+	TEXTURE_2D_MULTISAMPLE       TextureTarget = 0x9100
+	TEXTURE_2D_MULTISAMPLE_ARRAY TextureTarget = 0x9102
+	TEXTURE_CUBE_MAP_ARRAY       TextureTarget = 0x9009
+)
+
+const (
+	READ_FRAMEBUFFER FramebufferTarget = 0x8ca8
+	DRAW_FRAMEBUFFER FramebufferTarget = 0x8ca9
+	FRAMEBUFFER      FramebufferTarget = 0x8d40
+)
+
+const (
+	FRAGMENT_SHADER        ShaderType = 0x8b30
+	VERTEX_SHADER          ShaderType = 0x8b31
+	GEOMETRY_SHADER        ShaderType = 0x8dd9
+	TESS_EVALUATION_SHADER ShaderType = 0x8e87
+	TESS_CONTROL_SHADER    ShaderType = 0x8e88
+	COMPUTE_SHADER         ShaderType = 0x91b9
+)
+
+const (
+	NEVER    CompareFunc = 0x200
+	LESS     CompareFunc = 0x201
+	EQUAL    CompareFunc = 0x202
+	LEQUAL   CompareFunc = 0x203
+	GREATER  CompareFunc = 0x204
+	NOTEQUAL CompareFunc = 0x205
+	GEQUAL   CompareFunc = 0x206
+	ALWAYS   CompareFunc = 0x207
+)
+
+const (
+	DEPTH_BUFFER_BIT   ClearMask = 0x100
+	STENCIL_BUFFER_BIT ClearMask = 0x400
+	COLOR_BUFFER_BIT   ClearMask = 0x4000
+)
+
+const (
+	INVERT    StencilAction = 0x150a
+	KEEP      StencilAction = 0x1e00
+	REPLACE   StencilAction = 0x1e01
+	INCR      StencilAction = 0x1e02
+	DECR      StencilAction = 0x1e03
+	INCR_WRAP StencilAction = 0x8507
+	DECR_WRAP StencilAction = 0x8508
+)
+
+const (
+	FUNC_ADD              BlendOp = 0x8006
+	FUNC_REVERSE_SUBTRACT BlendOp = 0x800b
+	FUNC_SUBTRACT         BlendOp = 0x800a
+	MIN                   BlendOp = 0x8007
+	MAX                   BlendOp = 0x8008
+)
+
+const (
+	CW  Winding = 0x900
+	CCW Winding = 0x901
 )
@@ -0,0 +1,935 @@
+/* Code generated by glgen from gl.xml; DO NOT EDIT. */
+
+#include "glfuncs.h"
+
+static void APIENTRY gogl_stub_glActiveTexture(GLenum texture)
+{
+	gogl_unsupported("glActiveTexture");
+}
+GOGLPROC_glActiveTexture gogl_glActiveTexture = gogl_stub_glActiveTexture;
+
+static void APIENTRY gogl_stub_glAttachShader(GLuint program, GLuint shader)
+{
+	gogl_unsupported("glAttachShader");
+}
+GOGLPROC_glAttachShader gogl_glAttachShader = gogl_stub_glAttachShader;
+
+static void APIENTRY gogl_stub_glBindBuffer(GLenum target, GLuint buffer)
+{
+	gogl_unsupported("glBindBuffer");
+}
+GOGLPROC_glBindBuffer gogl_glBindBuffer = gogl_stub_glBindBuffer;
+
+static void APIENTRY gogl_stub_glBindBufferBase(GLenum target, GLuint index, GLuint buffer)
+{
+	gogl_unsupported("glBindBufferBase");
+}
+GOGLPROC_glBindBufferBase gogl_glBindBufferBase = gogl_stub_glBindBufferBase;
+
+static void APIENTRY gogl_stub_glBindBufferRange(GLenum target, GLuint index, GLuint buffer, GLintptr offset, GLsizeiptr size)
+{
+	gogl_unsupported("glBindBufferRange");
+}
+GOGLPROC_glBindBufferRange gogl_glBindBufferRange = gogl_stub_glBindBufferRange;
+
+static void APIENTRY gogl_stub_glBindFramebuffer(GLenum target, GLuint framebuffer)
+{
+	gogl_unsupported("glBindFramebuffer");
+}
+GOGLPROC_glBindFramebuffer gogl_glBindFramebuffer = gogl_stub_glBindFramebuffer;
+
+static void APIENTRY gogl_stub_glBindRenderbuffer(GLenum target, GLuint renderbuffer)
+{
+	gogl_unsupported("glBindRenderbuffer");
+}
+GOGLPROC_glBindRenderbuffer gogl_glBindRenderbuffer = gogl_stub_glBindRenderbuffer;
+
+static void APIENTRY gogl_stub_glBindTexture(GLenum target, GLuint texture)
+{
+	gogl_unsupported("glBindTexture");
+}
+GOGLPROC_glBindTexture gogl_glBindTexture = gogl_stub_glBindTexture;
+
+static void APIENTRY gogl_stub_glBindVertexArray(GLuint array)
+{
+	gogl_unsupported("glBindVertexArray");
+}
+GOGLPROC_glBindVertexArray gogl_glBindVertexArray = gogl_stub_glBindVertexArray;
+
+static void APIENTRY gogl_stub_glBlendColor(GLfloat red, GLfloat green, GLfloat blue, GLfloat alpha)
+{
+	gogl_unsupported("glBlendColor");
+}
+GOGLPROC_glBlendColor gogl_glBlendColor = gogl_stub_glBlendColor;
+
+static void APIENTRY gogl_stub_glBlendEquation(GLenum mode)
+{
+	gogl_unsupported("glBlendEquation");
+}
+GOGLPROC_glBlendEquation gogl_glBlendEquation = gogl_stub_glBlendEquation;
+
+static void APIENTRY gogl_stub_glBlendEquationSeparate(GLenum modeRGB, GLenum modeAlpha)
+{
+	gogl_unsupported("glBlendEquationSeparate");
+}
+GOGLPROC_glBlendEquationSeparate gogl_glBlendEquationSeparate = gogl_stub_glBlendEquationSeparate;
+
+static void APIENTRY gogl_stub_glBlendFunc(GLenum sfactor, GLenum dfactor)
+{
+	gogl_unsupported("glBlendFunc");
+}
+GOGLPROC_glBlendFunc gogl_glBlendFunc = gogl_stub_glBlendFunc;
+
+static void APIENTRY gogl_stub_glBlendFuncSeparate(GLenum sfactorRGB, GLenum dfactorRGB, GLenum sfactorAlpha, GLenum dfactorAlpha)
+{
+	gogl_unsupported("glBlendFuncSeparate");
+}
+GOGLPROC_glBlendFuncSeparate gogl_glBlendFuncSeparate = gogl_stub_glBlendFuncSeparate;
+
+static void APIENTRY gogl_stub_glBufferData(GLenum target, GLsizeiptr size, const void *data, GLenum usage)
+{
+	gogl_unsupported("glBufferData");
+}
+GOGLPROC_glBufferData gogl_glBufferData = gogl_stub_glBufferData;
+
+static void APIENTRY gogl_stub_glBufferSubData(GLenum target, GLintptr offset, GLsizeiptr size, const void *data)
+{
+	gogl_unsupported("glBufferSubData");
+}
+GOGLPROC_glBufferSubData gogl_glBufferSubData = gogl_stub_glBufferSubData;
+
+static GLenum APIENTRY gogl_stub_glCheckFramebufferStatus(GLenum target)
+{
+	gogl_unsupported("glCheckFramebufferStatus");
+	return (GLenum)0;
+}
+GOGLPROC_glCheckFramebufferStatus gogl_glCheckFramebufferStatus = gogl_stub_glCheckFramebufferStatus;
+
+static void APIENTRY gogl_stub_glClear(GLbitfield mask)
+{
+	gogl_unsupported("glClear");
+}
+GOGLPROC_glClear gogl_glClear = gogl_stub_glClear;
+
+static void APIENTRY gogl_stub_glClearColor(GLfloat red, GLfloat green, GLfloat blue, GLfloat alpha)
+{
+	gogl_unsupported("glClearColor");
+}
+GOGLPROC_glClearColor gogl_glClearColor = gogl_stub_glClearColor;
+
+static void APIENTRY gogl_stub_glClearDepth(GLdouble depth)
+{
+	gogl_unsupported("glClearDepth");
+}
+GOGLPROC_glClearDepth gogl_glClearDepth = gogl_stub_glClearDepth;
+
+static void APIENTRY gogl_stub_glClearStencil(GLint s)
+{
+	gogl_unsupported("glClearStencil");
+}
+GOGLPROC_glClearStencil gogl_glClearStencil = gogl_stub_glClearStencil;
+
+static GLenum APIENTRY gogl_stub_glClientWaitSync(GLsync sync, GLbitfield flags, GLuint64 timeout)
+{
+	gogl_unsupported("glClientWaitSync");
+	return (GLenum)0;
+}
+GOGLPROC_glClientWaitSync gogl_glClientWaitSync = gogl_stub_glClientWaitSync;
+
+static void APIENTRY gogl_stub_glClipControl(GLenum origin, GLenum depth)
+{
+	gogl_unsupported("glClipControl");
+}
+GOGLPROC_glClipControl gogl_glClipControl = gogl_stub_glClipControl;
+
+static void APIENTRY gogl_stub_glColorMask(GLboolean red, GLboolean green, GLboolean blue, GLboolean alpha)
+{
+	gogl_unsupported("glColorMask");
+}
+GOGLPROC_glColorMask gogl_glColorMask = gogl_stub_glColorMask;
+
+static void APIENTRY gogl_stub_glCompileShader(GLuint shader)
+{
+	gogl_unsupported("glCompileShader");
+}
+GOGLPROC_glCompileShader gogl_glCompileShader = gogl_stub_glCompileShader;
+
+static GLuint APIENTRY gogl_stub_glCreateProgram(void)
+{
+	gogl_unsupported("glCreateProgram");
+	return (GLuint)0;
+}
+GOGLPROC_glCreateProgram gogl_glCreateProgram = gogl_stub_glCreateProgram;
+
+static GLuint APIENTRY gogl_stub_glCreateShader(GLenum type)
+{
+	gogl_unsupported("glCreateShader");
+	return (GLuint)0;
+}
+GOGLPROC_glCreateShader gogl_glCreateShader = gogl_stub_glCreateShader;
+
+static void APIENTRY gogl_stub_glCullFace(GLenum mode)
+{
+	gogl_unsupported("glCullFace");
+}
+GOGLPROC_glCullFace gogl_glCullFace = gogl_stub_glCullFace;
+
+static void APIENTRY gogl_stub_glDebugMessageCallback(GLDEBUGPROC callback, const void *userParam)
+{
+	gogl_unsupported("glDebugMessageCallback");
+}
+GOGLPROC_glDebugMessageCallback gogl_glDebugMessageCallback = gogl_stub_glDebugMessageCallback;
+
+static void APIENTRY gogl_stub_glDebugMessageCallbackARB(GLDEBUGPROCARB callback, const void *userParam)
+{
+	gogl_unsupported("glDebugMessageCallbackARB");
+}
+GOGLPROC_glDebugMessageCallbackARB gogl_glDebugMessageCallbackARB = gogl_stub_glDebugMessageCallbackARB;
+
+static void APIENTRY gogl_stub_glDebugMessageControl(GLenum source, GLenum type, GLenum severity, GLsizei count, const GLuint *ids, GLboolean enabled)
+{
+	gogl_unsupported("glDebugMessageControl");
+}
+GOGLPROC_glDebugMessageControl gogl_glDebugMessageControl = gogl_stub_glDebugMessageControl;
+
+static void APIENTRY gogl_stub_glDebugMessageControlARB(GLenum source, GLenum type, GLenum severity, GLsizei count, const GLuint *ids, GLboolean enabled)
+{
+	gogl_unsupported("glDebugMessageControlARB");
+}
+GOGLPROC_glDebugMessageControlARB gogl_glDebugMessageControlARB = gogl_stub_glDebugMessageControlARB;
+
+static void APIENTRY gogl_stub_glDebugMessageInsert(GLenum source, GLenum type, GLuint id, GLenum severity, GLsizei length, const GLchar *buf)
+{
+	gogl_unsupported("glDebugMessageInsert");
+}
+GOGLPROC_glDebugMessageInsert gogl_glDebugMessageInsert = gogl_stub_glDebugMessageInsert;
+
+static void APIENTRY gogl_stub_glDebugMessageInsertARB(GLenum source, GLenum type, GLuint id, GLenum severity, GLsizei length, const GLchar *buf)
+{
+	gogl_unsupported("glDebugMessageInsertARB");
+}
+GOGLPROC_glDebugMessageInsertARB gogl_glDebugMessageInsertARB = gogl_stub_glDebugMessageInsertARB;
+
+static void APIENTRY gogl_stub_glDeleteBuffers(GLsizei n, const GLuint *buffers)
+{
+	gogl_unsupported("glDeleteBuffers");
+}
+GOGLPROC_glDeleteBuffers gogl_glDeleteBuffers = gogl_stub_glDeleteBuffers;
+
+static void APIENTRY gogl_stub_glDeleteFramebuffers(GLsizei n, const GLuint *framebuffers)
+{
+	gogl_unsupported("glDeleteFramebuffers");
+}
+GOGLPROC_glDeleteFramebuffers gogl_glDeleteFramebuffers = gogl_stub_glDeleteFramebuffers;
+
+static void APIENTRY gogl_stub_glDeleteProgram(GLuint program)
+{
+	gogl_unsupported("glDeleteProgram");
+}
+GOGLPROC_glDeleteProgram gogl_glDeleteProgram = gogl_stub_glDeleteProgram;
+
+static void APIENTRY gogl_stub_glDeleteRenderbuffers(GLsizei n, const GLuint *renderbuffers)
+{
+	gogl_unsupported("glDeleteRenderbuffers");
+}
+GOGLPROC_glDeleteRenderbuffers gogl_glDeleteRenderbuffers = gogl_stub_glDeleteRenderbuffers;
+
+static void APIENTRY gogl_stub_glDeleteShader(GLuint shader)
+{
+	gogl_unsupported("glDeleteShader");
+}
+GOGLPROC_glDeleteShader gogl_glDeleteShader = gogl_stub_glDeleteShader;
+
+static void APIENTRY gogl_stub_glDeleteSync(GLsync sync)
+{
+	gogl_unsupported("glDeleteSync");
+}
+GOGLPROC_glDeleteSync gogl_glDeleteSync = gogl_stub_glDeleteSync;
+
+static void APIENTRY gogl_stub_glDeleteTextures(GLsizei n, const GLuint *textures)
+{
+	gogl_unsupported("glDeleteTextures");
+}
+GOGLPROC_glDeleteTextures gogl_glDeleteTextures = gogl_stub_glDeleteTextures;
+
+static void APIENTRY gogl_stub_glDeleteVertexArrays(GLsizei n, const GLuint *arrays)
+{
+	gogl_unsupported("glDeleteVertexArrays");
+}
+GOGLPROC_glDeleteVertexArrays gogl_glDeleteVertexArrays = gogl_stub_glDeleteVertexArrays;
+
+static void APIENTRY gogl_stub_glDepthFunc(GLenum func)
+{
+	gogl_unsupported("glDepthFunc");
+}
+GOGLPROC_glDepthFunc gogl_glDepthFunc = gogl_stub_glDepthFunc;
+
+static void APIENTRY gogl_stub_glDepthMask(GLboolean flag)
+{
+	gogl_unsupported("glDepthMask");
+}
+GOGLPROC_glDepthMask gogl_glDepthMask = gogl_stub_glDepthMask;
+
+static void APIENTRY gogl_stub_glDepthRange(GLdouble n, GLdouble f)
+{
+	gogl_unsupported("glDepthRange");
+}
+GOGLPROC_glDepthRange gogl_glDepthRange = gogl_stub_glDepthRange;
+
+static void APIENTRY gogl_stub_glDetachShader(GLuint program, GLuint shader)
+{
+	gogl_unsupported("glDetachShader");
+}
+GOGLPROC_glDetachShader gogl_glDetachShader = gogl_stub_glDetachShader;
+
+static void APIENTRY gogl_stub_glDisable(GLenum cap)
+{
+	gogl_unsupported("glDisable");
+}
+GOGLPROC_glDisable gogl_glDisable = gogl_stub_glDisable;
+
+static void APIENTRY gogl_stub_glDisableVertexAttribArray(GLuint index)
+{
+	gogl_unsupported("glDisableVertexAttribArray");
+}
+GOGLPROC_glDisableVertexAttribArray gogl_glDisableVertexAttribArray = gogl_stub_glDisableVertexAttribArray;
+
+static void APIENTRY gogl_stub_glDrawArrays(GLenum mode, GLint first, GLsizei count)
+{
+	gogl_unsupported("glDrawArrays");
+}
+GOGLPROC_glDrawArrays gogl_glDrawArrays = gogl_stub_glDrawArrays;
+
+static void APIENTRY gogl_stub_glDrawArraysInstanced(GLenum mode, GLint first, GLsizei count, GLsizei instancecount)
+{
+	gogl_unsupported("glDrawArraysInstanced");
+}
+GOGLPROC_glDrawArraysInstanced gogl_glDrawArraysInstanced = gogl_stub_glDrawArraysInstanced;
+
+static void APIENTRY gogl_stub_glDrawBuffers(GLsizei n, const GLenum *bufs)
+{
+	gogl_unsupported("glDrawBuffers");
+}
+GOGLPROC_glDrawBuffers gogl_glDrawBuffers = gogl_stub_glDrawBuffers;
+
+static void APIENTRY gogl_stub_glDrawElements(GLenum mode, GLsizei count, GLenum type, const void *indices)
+{
+	gogl_unsupported("glDrawElements");
+}
+GOGLPROC_glDrawElements gogl_glDrawElements = gogl_stub_glDrawElements;
+
+static void APIENTRY gogl_stub_glDrawElementsBaseVertex(GLenum mode, GLsizei count, GLenum type, const void *indices, GLint basevertex)
+{
+	gogl_unsupported("glDrawElementsBaseVertex");
+}
+GOGLPROC_glDrawElementsBaseVertex gogl_glDrawElementsBaseVertex = gogl_stub_glDrawElementsBaseVertex;
+
+static void APIENTRY gogl_stub_glDrawElementsInstanced(GLenum mode, GLsizei count, GLenum type, const void *indices, GLsizei instancecount)
+{
+	gogl_unsupported("glDrawElementsInstanced");
+}
+GOGLPROC_glDrawElementsInstanced gogl_glDrawElementsInstanced = gogl_stub_glDrawElementsInstanced;
+
+static void APIENTRY gogl_stub_glDrawRangeElements(GLenum mode, GLuint start, GLuint end, GLsizei count, GLenum type, const void *indices)
+{
+	gogl_unsupported("glDrawRangeElements");
+}
+GOGLPROC_glDrawRangeElements gogl_glDrawRangeElements = gogl_stub_glDrawRangeElements;
+
+static void APIENTRY gogl_stub_glEnable(GLenum cap)
+{
+	gogl_unsupported("glEnable");
+}
+GOGLPROC_glEnable gogl_glEnable = gogl_stub_glEnable;
+
+static void APIENTRY gogl_stub_glEnableVertexAttribArray(GLuint index)
+{
+	gogl_unsupported("glEnableVertexAttribArray");
+}
+GOGLPROC_glEnableVertexAttribArray gogl_glEnableVertexAttribArray = gogl_stub_glEnableVertexAttribArray;
+
+static GLsync APIENTRY gogl_stub_glFenceSync(GLenum condition, GLbitfield flags)
+{
+	gogl_unsupported("glFenceSync");
+	return (GLsync)0;
+}
+GOGLPROC_glFenceSync gogl_glFenceSync = gogl_stub_glFenceSync;
+
+static void APIENTRY gogl_stub_glFramebufferRenderbuffer(GLenum target, GLenum attachment, GLenum renderbuffertarget, GLuint renderbuffer)
+{
+	gogl_unsupported("glFramebufferRenderbuffer");
+}
+GOGLPROC_glFramebufferRenderbuffer gogl_glFramebufferRenderbuffer = gogl_stub_glFramebufferRenderbuffer;
+
+static void APIENTRY gogl_stub_glFramebufferTexture2D(GLenum target, GLenum attachment, GLenum textarget, GLuint texture, GLint level)
+{
+	gogl_unsupported("glFramebufferTexture2D");
+}
+GOGLPROC_glFramebufferTexture2D gogl_glFramebufferTexture2D = gogl_stub_glFramebufferTexture2D;
+
+static void APIENTRY gogl_stub_glFramebufferTextureLayer(GLenum target, GLenum attachment, GLuint texture, GLint level, GLint layer)
+{
+	gogl_unsupported("glFramebufferTextureLayer");
+}
+GOGLPROC_glFramebufferTextureLayer gogl_glFramebufferTextureLayer = gogl_stub_glFramebufferTextureLayer;
+
+static void APIENTRY gogl_stub_glFrontFace(GLenum mode)
+{
+	gogl_unsupported("glFrontFace");
+}
+GOGLPROC_glFrontFace gogl_glFrontFace = gogl_stub_glFrontFace;
+
+static void APIENTRY gogl_stub_glGenBuffers(GLsizei n, GLuint *buffers)
+{
+	gogl_unsupported("glGenBuffers");
+}
+GOGLPROC_glGenBuffers gogl_glGenBuffers = gogl_stub_glGenBuffers;
+
+static void APIENTRY gogl_stub_glGenFramebuffers(GLsizei n, GLuint *framebuffers)
+{
+	gogl_unsupported("glGenFramebuffers");
+}
+GOGLPROC_glGenFramebuffers gogl_glGenFramebuffers = gogl_stub_glGenFramebuffers;
+
+static void APIENTRY gogl_stub_glGenRenderbuffers(GLsizei n, GLuint *renderbuffers)
+{
+	gogl_unsupported("glGenRenderbuffers");
+}
+GOGLPROC_glGenRenderbuffers gogl_glGenRenderbuffers = gogl_stub_glGenRenderbuffers;
+
+static void APIENTRY gogl_stub_glGenTextures(GLsizei n, GLuint *textures)
+{
+	gogl_unsupported("glGenTextures");
+}
+GOGLPROC_glGenTextures gogl_glGenTextures = gogl_stub_glGenTextures;
+
+static void APIENTRY gogl_stub_glGenVertexArrays(GLsizei n, GLuint *arrays)
+{
+	gogl_unsupported("glGenVertexArrays");
+}
+GOGLPROC_glGenVertexArrays gogl_glGenVertexArrays = gogl_stub_glGenVertexArrays;
+
+static void APIENTRY gogl_stub_glGenerateMipmap(GLenum target)
+{
+	gogl_unsupported("glGenerateMipmap");
+}
+GOGLPROC_glGenerateMipmap gogl_glGenerateMipmap = gogl_stub_glGenerateMipmap;
+
+static void APIENTRY gogl_stub_glGetActiveAttrib(GLuint program, GLuint index, GLsizei bufSize, GLsizei *length, GLint *size, GLenum *type, GLchar *name)
+{
+	gogl_unsupported("glGetActiveAttrib");
+}
+GOGLPROC_glGetActiveAttrib gogl_glGetActiveAttrib = gogl_stub_glGetActiveAttrib;
+
+static void APIENTRY gogl_stub_glGetActiveUniform(GLuint program, GLuint index, GLsizei bufSize, GLsizei *length, GLint *size, GLenum *type, GLchar *name)
+{
+	gogl_unsupported("glGetActiveUniform");
+}
+GOGLPROC_glGetActiveUniform gogl_glGetActiveUniform = gogl_stub_glGetActiveUniform;
+
+static void APIENTRY gogl_stub_glGetActiveUniformBlockName(GLuint program, GLuint uniformBlockIndex, GLsizei bufSize, GLsizei *length, GLchar *uniformBlockName)
+{
+	gogl_unsupported("glGetActiveUniformBlockName");
+}
+GOGLPROC_glGetActiveUniformBlockName gogl_glGetActiveUniformBlockName = gogl_stub_glGetActiveUniformBlockName;
+
+static void APIENTRY gogl_stub_glGetActiveUniformBlockiv(GLuint program, GLuint uniformBlockIndex, GLenum pname, GLint *params)
+{
+	gogl_unsupported("glGetActiveUniformBlockiv");
+}
+GOGLPROC_glGetActiveUniformBlockiv gogl_glGetActiveUniformBlockiv = gogl_stub_glGetActiveUniformBlockiv;
+
+static void APIENTRY gogl_stub_glGetActiveUniformsiv(GLuint program, GLsizei uniformCount, const GLuint *uniformIndices, GLenum pname, GLint *params)
+{
+	gogl_unsupported("glGetActiveUniformsiv");
+}
+GOGLPROC_glGetActiveUniformsiv gogl_glGetActiveUniformsiv = gogl_stub_glGetActiveUniformsiv;
+
+static GLint APIENTRY gogl_stub_glGetAttribLocation(GLuint program, const GLchar *name)
+{
+	gogl_unsupported("glGetAttribLocation");
+	return (GLint)0;
+}
+GOGLPROC_glGetAttribLocation gogl_glGetAttribLocation = gogl_stub_glGetAttribLocation;
+
+static GLenum APIENTRY gogl_stub_glGetError(void)
+{
+	gogl_unsupported("glGetError");
+	return (GLenum)0;
+}
+GOGLPROC_glGetError gogl_glGetError = gogl_stub_glGetError;
+
+static void APIENTRY gogl_stub_glGetIntegerv(GLenum pname, GLint *data)
+{
+	gogl_unsupported("glGetIntegerv");
+}
+GOGLPROC_glGetIntegerv gogl_glGetIntegerv = gogl_stub_glGetIntegerv;
+
+static void APIENTRY gogl_stub_glGetProgramInfoLog(GLuint program, GLsizei bufSize, GLsizei *length, GLchar *infoLog)
+{
+	gogl_unsupported("glGetProgramInfoLog");
+}
+GOGLPROC_glGetProgramInfoLog gogl_glGetProgramInfoLog = gogl_stub_glGetProgramInfoLog;
+
+static void APIENTRY gogl_stub_glGetProgramiv(GLuint program, GLenum pname, GLint *params)
+{
+	gogl_unsupported("glGetProgramiv");
+}
+GOGLPROC_glGetProgramiv gogl_glGetProgramiv = gogl_stub_glGetProgramiv;
+
+static void APIENTRY gogl_stub_glGetShaderInfoLog(GLuint shader, GLsizei bufSize, GLsizei *length, GLchar *infoLog)
+{
+	gogl_unsupported("glGetShaderInfoLog");
+}
+GOGLPROC_glGetShaderInfoLog gogl_glGetShaderInfoLog = gogl_stub_glGetShaderInfoLog;
+
+static void APIENTRY gogl_stub_glGetShaderiv(GLuint shader, GLenum pname, GLint *params)
+{
+	gogl_unsupported("glGetShaderiv");
+}
+GOGLPROC_glGetShaderiv gogl_glGetShaderiv = gogl_stub_glGetShaderiv;
+
+static const GLubyte * APIENTRY gogl_stub_glGetString(GLenum name)
+{
+	gogl_unsupported("glGetString");
+	return (const GLubyte *)0;
+}
+GOGLPROC_glGetString gogl_glGetString = gogl_stub_glGetString;
+
+static const GLubyte * APIENTRY gogl_stub_glGetStringi(GLenum name, GLuint index)
+{
+	gogl_unsupported("glGetStringi");
+	return (const GLubyte *)0;
+}
+GOGLPROC_glGetStringi gogl_glGetStringi = gogl_stub_glGetStringi;
+
+static GLint APIENTRY gogl_stub_glGetUniformLocation(GLuint program, const GLchar *name)
+{
+	gogl_unsupported("glGetUniformLocation");
+	return (GLint)0;
+}
+GOGLPROC_glGetUniformLocation gogl_glGetUniformLocation = gogl_stub_glGetUniformLocation;
+
+static void APIENTRY gogl_stub_glLineWidth(GLfloat width)
+{
+	gogl_unsupported("glLineWidth");
+}
+GOGLPROC_glLineWidth gogl_glLineWidth = gogl_stub_glLineWidth;
+
+static void APIENTRY gogl_stub_glLinkProgram(GLuint program)
+{
+	gogl_unsupported("glLinkProgram");
+}
+GOGLPROC_glLinkProgram gogl_glLinkProgram = gogl_stub_glLinkProgram;
+
+static void * APIENTRY gogl_stub_glMapBufferRange(GLenum target, GLintptr offset, GLsizeiptr length, GLbitfield access)
+{
+	gogl_unsupported("glMapBufferRange");
+	return (void *)0;
+}
+GOGLPROC_glMapBufferRange gogl_glMapBufferRange = gogl_stub_glMapBufferRange;
+
+static void APIENTRY gogl_stub_glObjectLabel(GLenum identifier, GLuint name, GLsizei length, const GLchar *label)
+{
+	gogl_unsupported("glObjectLabel");
+}
+GOGLPROC_glObjectLabel gogl_glObjectLabel = gogl_stub_glObjectLabel;
+
+static void APIENTRY gogl_stub_glPixelStorei(GLenum pname, GLint param)
+{
+	gogl_unsupported("glPixelStorei");
+}
+GOGLPROC_glPixelStorei gogl_glPixelStorei = gogl_stub_glPixelStorei;
+
+static void APIENTRY gogl_stub_glPolygonMode(GLenum face, GLenum mode)
+{
+	gogl_unsupported("glPolygonMode");
+}
+GOGLPROC_glPolygonMode gogl_glPolygonMode = gogl_stub_glPolygonMode;
+
+static void APIENTRY gogl_stub_glPolygonOffset(GLfloat factor, GLfloat units)
+{
+	gogl_unsupported("glPolygonOffset");
+}
+GOGLPROC_glPolygonOffset gogl_glPolygonOffset = gogl_stub_glPolygonOffset;
+
+static void APIENTRY gogl_stub_glPopDebugGroup(void)
+{
+	gogl_unsupported("glPopDebugGroup");
+}
+GOGLPROC_glPopDebugGroup gogl_glPopDebugGroup = gogl_stub_glPopDebugGroup;
+
+static void APIENTRY gogl_stub_glPushDebugGroup(GLenum source, GLuint id, GLsizei length, const GLchar *message)
+{
+	gogl_unsupported("glPushDebugGroup");
+}
+GOGLPROC_glPushDebugGroup gogl_glPushDebugGroup = gogl_stub_glPushDebugGroup;
+
+static void APIENTRY gogl_stub_glReadBuffer(GLenum src)
+{
+	gogl_unsupported("glReadBuffer");
+}
+GOGLPROC_glReadBuffer gogl_glReadBuffer = gogl_stub_glReadBuffer;
+
+static void APIENTRY gogl_stub_glReadPixels(GLint x, GLint y, GLsizei width, GLsizei height, GLenum format, GLenum type, void *pixels)
+{
+	gogl_unsupported("glReadPixels");
+}
+GOGLPROC_glReadPixels gogl_glReadPixels = gogl_stub_glReadPixels;
+
+static void APIENTRY gogl_stub_glRenderbufferStorageMultisample(GLenum target, GLsizei samples, GLenum internalformat, GLsizei width, GLsizei height)
+{
+	gogl_unsupported("glRenderbufferStorageMultisample");
+}
+GOGLPROC_glRenderbufferStorageMultisample gogl_glRenderbufferStorageMultisample = gogl_stub_glRenderbufferStorageMultisample;
+
+static void APIENTRY gogl_stub_glScissor(GLint x, GLint y, GLsizei width, GLsizei height)
+{
+	gogl_unsupported("glScissor");
+}
+GOGLPROC_glScissor gogl_glScissor = gogl_stub_glScissor;
+
+static void APIENTRY gogl_stub_glShaderSource(GLuint shader, GLsizei count, const GLchar *const*string, const GLint *length)
+{
+	gogl_unsupported("glShaderSource");
+}
+GOGLPROC_glShaderSource gogl_glShaderSource = gogl_stub_glShaderSource;
+
+static void APIENTRY gogl_stub_glStencilFunc(GLenum func, GLint ref, GLuint mask)
+{
+	gogl_unsupported("glStencilFunc");
+}
+GOGLPROC_glStencilFunc gogl_glStencilFunc = gogl_stub_glStencilFunc;
+
+static void APIENTRY gogl_stub_glStencilFuncSeparate(GLenum face, GLenum func, GLint ref, GLuint mask)
+{
+	gogl_unsupported("glStencilFuncSeparate");
+}
+GOGLPROC_glStencilFuncSeparate gogl_glStencilFuncSeparate = gogl_stub_glStencilFuncSeparate;
+
+static void APIENTRY gogl_stub_glStencilMask(GLuint mask)
+{
+	gogl_unsupported("glStencilMask");
+}
+GOGLPROC_glStencilMask gogl_glStencilMask = gogl_stub_glStencilMask;
+
+static void APIENTRY gogl_stub_glStencilMaskSeparate(GLenum face, GLuint mask)
+{
+	gogl_unsupported("glStencilMaskSeparate");
+}
+GOGLPROC_glStencilMaskSeparate gogl_glStencilMaskSeparate = gogl_stub_glStencilMaskSeparate;
+
+static void APIENTRY gogl_stub_glStencilOp(GLenum fail, GLenum zfail, GLenum zpass)
+{
+	gogl_unsupported("glStencilOp");
+}
+GOGLPROC_glStencilOp gogl_glStencilOp = gogl_stub_glStencilOp;
+
+static void APIENTRY gogl_stub_glStencilOpSeparate(GLenum face, GLenum sfail, GLenum dpfail, GLenum dppass)
+{
+	gogl_unsupported("glStencilOpSeparate");
+}
+GOGLPROC_glStencilOpSeparate gogl_glStencilOpSeparate = gogl_stub_glStencilOpSeparate;
+
+static void APIENTRY gogl_stub_glTexImage2D(GLenum target, GLint level, GLint internalformat, GLsizei width, GLsizei height, GLint border, GLenum format, GLenum type, const void *pixels)
+{
+	gogl_unsupported("glTexImage2D");
+}
+GOGLPROC_glTexImage2D gogl_glTexImage2D = gogl_stub_glTexImage2D;
+
+static void APIENTRY gogl_stub_glTexImage3D(GLenum target, GLint level, GLint internalformat, GLsizei width, GLsizei height, GLsizei depth, GLint border, GLenum format, GLenum type, const void *pixels)
+{
+	gogl_unsupported("glTexImage3D");
+}
+GOGLPROC_glTexImage3D gogl_glTexImage3D = gogl_stub_glTexImage3D;
+
+static void APIENTRY gogl_stub_glTexParameteri(GLenum target, GLenum pname, GLint param)
+{
+	gogl_unsupported("glTexParameteri");
+}
+GOGLPROC_glTexParameteri gogl_glTexParameteri = gogl_stub_glTexParameteri;
+
+static void APIENTRY gogl_stub_glTexSubImage2D(GLenum target, GLint level, GLint xoffset, GLint yoffset, GLsizei width, GLsizei height, GLenum format, GLenum type, const void *pixels)
+{
+	gogl_unsupported("glTexSubImage2D");
+}
+GOGLPROC_glTexSubImage2D gogl_glTexSubImage2D = gogl_stub_glTexSubImage2D;
+
+static void APIENTRY gogl_stub_glTexSubImage3D(GLenum target, GLint level, GLint xoffset, GLint yoffset, GLint zoffset, GLsizei width, GLsizei height, GLsizei depth, GLenum format, GLenum type, const void *pixels)
+{
+	gogl_unsupported("glTexSubImage3D");
+}
+GOGLPROC_glTexSubImage3D gogl_glTexSubImage3D = gogl_stub_glTexSubImage3D;
+
+static void APIENTRY gogl_stub_glUniform1f(GLint location, GLfloat v0)
+{
+	gogl_unsupported("glUniform1f");
+}
+GOGLPROC_glUniform1f gogl_glUniform1f = gogl_stub_glUniform1f;
+
+static void APIENTRY gogl_stub_glUniform1i(GLint location, GLint v0)
+{
+	gogl_unsupported("glUniform1i");
+}
+GOGLPROC_glUniform1i gogl_glUniform1i = gogl_stub_glUniform1i;
+
+static void APIENTRY gogl_stub_glUniform2f(GLint location, GLfloat v0, GLfloat v1)
+{
+	gogl_unsupported("glUniform2f");
+}
+GOGLPROC_glUniform2f gogl_glUniform2f = gogl_stub_glUniform2f;
+
+static void APIENTRY gogl_stub_glUniform2i(GLint location, GLint v0, GLint v1)
+{
+	gogl_unsupported("glUniform2i");
+}
+GOGLPROC_glUniform2i gogl_glUniform2i = gogl_stub_glUniform2i;
+
+static void APIENTRY gogl_stub_glUniform3f(GLint location, GLfloat v0, GLfloat v1, GLfloat v2)
+{
+	gogl_unsupported("glUniform3f");
+}
+GOGLPROC_glUniform3f gogl_glUniform3f = gogl_stub_glUniform3f;
+
+static void APIENTRY gogl_stub_glUniform3i(GLint location, GLint v0, GLint v1, GLint v2)
+{
+	gogl_unsupported("glUniform3i");
+}
+GOGLPROC_glUniform3i gogl_glUniform3i = gogl_stub_glUniform3i;
+
+static void APIENTRY gogl_stub_glUniform4f(GLint location, GLfloat v0, GLfloat v1, GLfloat v2, GLfloat v3)
+{
+	gogl_unsupported("glUniform4f");
+}
+GOGLPROC_glUniform4f gogl_glUniform4f = gogl_stub_glUniform4f;
+
+static void APIENTRY gogl_stub_glUniform4i(GLint location, GLint v0, GLint v1, GLint v2, GLint v3)
+{
+	gogl_unsupported("glUniform4i");
+}
+GOGLPROC_glUniform4i gogl_glUniform4i = gogl_stub_glUniform4i;
+
+static void APIENTRY gogl_stub_glUniformBlockBinding(GLuint program, GLuint uniformBlockIndex, GLuint uniformBlockBinding)
+{
+	gogl_unsupported("glUniformBlockBinding");
+}
+GOGLPROC_glUniformBlockBinding gogl_glUniformBlockBinding = gogl_stub_glUniformBlockBinding;
+
+static void APIENTRY gogl_stub_glUniformMatrix2fv(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value)
+{
+	gogl_unsupported("glUniformMatrix2fv");
+}
+GOGLPROC_glUniformMatrix2fv gogl_glUniformMatrix2fv = gogl_stub_glUniformMatrix2fv;
+
+static void APIENTRY gogl_stub_glUniformMatrix2x3fv(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value)
+{
+	gogl_unsupported("glUniformMatrix2x3fv");
+}
+GOGLPROC_glUniformMatrix2x3fv gogl_glUniformMatrix2x3fv = gogl_stub_glUniformMatrix2x3fv;
+
+static void APIENTRY gogl_stub_glUniformMatrix2x4fv(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value)
+{
+	gogl_unsupported("glUniformMatrix2x4fv");
+}
+GOGLPROC_glUniformMatrix2x4fv gogl_glUniformMatrix2x4fv = gogl_stub_glUniformMatrix2x4fv;
+
+static void APIENTRY gogl_stub_glUniformMatrix3fv(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value)
+{
+	gogl_unsupported("glUniformMatrix3fv");
+}
+GOGLPROC_glUniformMatrix3fv gogl_glUniformMatrix3fv = gogl_stub_glUniformMatrix3fv;
+
+static void APIENTRY gogl_stub_glUniformMatrix3x2fv(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value)
+{
+	gogl_unsupported("glUniformMatrix3x2fv");
+}
+GOGLPROC_glUniformMatrix3x2fv gogl_glUniformMatrix3x2fv = gogl_stub_glUniformMatrix3x2fv;
+
+static void APIENTRY gogl_stub_glUniformMatrix3x4fv(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value)
+{
+	gogl_unsupported("glUniformMatrix3x4fv");
+}
+GOGLPROC_glUniformMatrix3x4fv gogl_glUniformMatrix3x4fv = gogl_stub_glUniformMatrix3x4fv;
+
+static void APIENTRY gogl_stub_glUniformMatrix4fv(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value)
+{
+	gogl_unsupported("glUniformMatrix4fv");
+}
+GOGLPROC_glUniformMatrix4fv gogl_glUniformMatrix4fv = gogl_stub_glUniformMatrix4fv;
+
+static void APIENTRY gogl_stub_glUniformMatrix4x2fv(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value)
+{
+	gogl_unsupported("glUniformMatrix4x2fv");
+}
+GOGLPROC_glUniformMatrix4x2fv gogl_glUniformMatrix4x2fv = gogl_stub_glUniformMatrix4x2fv;
+
+static void APIENTRY gogl_stub_glUniformMatrix4x3fv(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value)
+{
+	gogl_unsupported("glUniformMatrix4x3fv");
+}
+GOGLPROC_glUniformMatrix4x3fv gogl_glUniformMatrix4x3fv = gogl_stub_glUniformMatrix4x3fv;
+
+static GLboolean APIENTRY gogl_stub_glUnmapBuffer(GLenum target)
+{
+	gogl_unsupported("glUnmapBuffer");
+	return (GLboolean)0;
+}
+GOGLPROC_glUnmapBuffer gogl_glUnmapBuffer = gogl_stub_glUnmapBuffer;
+
+static void APIENTRY gogl_stub_glUseProgram(GLuint program)
+{
+	gogl_unsupported("glUseProgram");
+}
+GOGLPROC_glUseProgram gogl_glUseProgram = gogl_stub_glUseProgram;
+
+static void APIENTRY gogl_stub_glVertexAttribDivisor(GLuint index, GLuint divisor)
+{
+	gogl_unsupported("glVertexAttribDivisor");
+}
+GOGLPROC_glVertexAttribDivisor gogl_glVertexAttribDivisor = gogl_stub_glVertexAttribDivisor;
+
+static void APIENTRY gogl_stub_glVertexAttribPointer(GLuint index, GLint size, GLenum type, GLboolean normalized, GLsizei stride, const void *pointer)
+{
+	gogl_unsupported("glVertexAttribPointer");
+}
+GOGLPROC_glVertexAttribPointer gogl_glVertexAttribPointer = gogl_stub_glVertexAttribPointer;
+
+static void APIENTRY gogl_stub_glViewport(GLint x, GLint y, GLsizei width, GLsizei height)
+{
+	gogl_unsupported("glViewport");
+}
+GOGLPROC_glViewport gogl_glViewport = gogl_stub_glViewport;
+
+struct gogl_func gogl_funcs[GOGL_NFUNCS] = {
+	{"glActiveTexture", (void **)&gogl_glActiveTexture, (void *)gogl_stub_glActiveTexture, 13, ""},
+	{"glAttachShader", (void **)&gogl_glAttachShader, (void *)gogl_stub_glAttachShader, 20, ""},
+	{"glBindBuffer", (void **)&gogl_glBindBuffer, (void *)gogl_stub_glBindBuffer, 15, ""},
+	{"glBindBufferBase", (void **)&gogl_glBindBufferBase, (void *)gogl_stub_glBindBufferBase, 30, "GL_ARB_uniform_buffer_object"},
+	{"glBindBufferRange", (void **)&gogl_glBindBufferRange, (void *)gogl_stub_glBindBufferRange, 30, "GL_ARB_uniform_buffer_object"},
+	{"glBindFramebuffer", (void **)&gogl_glBindFramebuffer, (void *)gogl_stub_glBindFramebuffer, 30, "GL_ARB_framebuffer_object"},
+	{"glBindRenderbuffer", (void **)&gogl_glBindRenderbuffer, (void *)gogl_stub_glBindRenderbuffer, 30, "GL_ARB_framebuffer_object"},
+	{"glBindTexture", (void **)&gogl_glBindTexture, (void *)gogl_stub_glBindTexture, 11, ""},
+	{"glBindVertexArray", (void **)&gogl_glBindVertexArray, (void *)gogl_stub_glBindVertexArray, 30, "GL_ARB_vertex_array_object"},
+	{"glBlendColor", (void **)&gogl_glBlendColor, (void *)gogl_stub_glBlendColor, 14, ""},
+	{"glBlendEquation", (void **)&gogl_glBlendEquation, (void *)gogl_stub_glBlendEquation, 14, ""},
+	{"glBlendEquationSeparate", (void **)&gogl_glBlendEquationSeparate, (void *)gogl_stub_glBlendEquationSeparate, 20, ""},
+	{"glBlendFunc", (void **)&gogl_glBlendFunc, (void *)gogl_stub_glBlendFunc, 10, ""},
+	{"glBlendFuncSeparate", (void **)&gogl_glBlendFuncSeparate, (void *)gogl_stub_glBlendFuncSeparate, 14, ""},
+	{"glBufferData", (void **)&gogl_glBufferData, (void *)gogl_stub_glBufferData, 15, ""},
+	{"glBufferSubData", (void **)&gogl_glBufferSubData, (void *)gogl_stub_glBufferSubData, 15, ""},
+	{"glCheckFramebufferStatus", (void **)&gogl_glCheckFramebufferStatus, (void *)gogl_stub_glCheckFramebufferStatus, 30, "GL_ARB_framebuffer_object"},
+	{"glClear", (void **)&gogl_glClear, (void *)gogl_stub_glClear, 10, ""},
+	{"glClearColor", (void **)&gogl_glClearColor, (void *)gogl_stub_glClearColor, 10, ""},
+	{"glClearDepth", (void **)&gogl_glClearDepth, (void *)gogl_stub_glClearDepth, 10, ""},
+	{"glClearStencil", (void **)&gogl_glClearStencil, (void *)gogl_stub_glClearStencil, 10, ""},
+	{"glClientWaitSync", (void **)&gogl_glClientWaitSync, (void *)gogl_stub_glClientWaitSync, 32, "GL_ARB_sync"},
+	{"glClipControl", (void **)&gogl_glClipControl, (void *)gogl_stub_glClipControl, 45, "GL_ARB_clip_control"},
+	{"glColorMask", (void **)&gogl_glColorMask, (void *)gogl_stub_glColorMask, 10, ""},
+	{"glCompileShader", (void **)&gogl_glCompileShader, (void *)gogl_stub_glCompileShader, 20, ""},
+	{"glCreateProgram", (void **)&gogl_glCreateProgram, (void *)gogl_stub_glCreateProgram, 20, ""},
+	{"glCreateShader", (void **)&gogl_glCreateShader, (void *)gogl_stub_glCreateShader, 20, ""},
+	{"glCullFace", (void **)&gogl_glCullFace, (void *)gogl_stub_glCullFace, 10, ""},
+	{"glDebugMessageCallback", (void **)&gogl_glDebugMessageCallback, (void *)gogl_stub_glDebugMessageCallback, 43, "GL_KHR_debug"},
+	{"glDebugMessageCallbackARB", (void **)&gogl_glDebugMessageCallbackARB, (void *)gogl_stub_glDebugMessageCallbackARB, 0, "GL_ARB_debug_output"},
+	{"glDebugMessageControl", (void **)&gogl_glDebugMessageControl, (void *)gogl_stub_glDebugMessageControl, 43, "GL_KHR_debug"},
+	{"glDebugMessageControlARB", (void **)&gogl_glDebugMessageControlARB, (void *)gogl_stub_glDebugMessageControlARB, 0, "GL_ARB_debug_output"},
+	{"glDebugMessageInsert", (void **)&gogl_glDebugMessageInsert, (void *)gogl_stub_glDebugMessageInsert, 43, "GL_KHR_debug"},
+	{"glDebugMessageInsertARB", (void **)&gogl_glDebugMessageInsertARB, (void *)gogl_stub_glDebugMessageInsertARB, 0, "GL_ARB_debug_output"},
+	{"glDeleteBuffers", (void **)&gogl_glDeleteBuffers, (void *)gogl_stub_glDeleteBuffers, 15, ""},
+	{"glDeleteFramebuffers", (void **)&gogl_glDeleteFramebuffers, (void *)gogl_stub_glDeleteFramebuffers, 30, "GL_ARB_framebuffer_object"},
+	{"glDeleteProgram", (void **)&gogl_glDeleteProgram, (void *)gogl_stub_glDeleteProgram, 20, ""},
+	{"glDeleteRenderbuffers", (void **)&gogl_glDeleteRenderbuffers, (void *)gogl_stub_glDeleteRenderbuffers, 30, "GL_ARB_framebuffer_object"},
+	{"glDeleteShader", (void **)&gogl_glDeleteShader, (void *)gogl_stub_glDeleteShader, 20, ""},
+	{"glDeleteSync", (void **)&gogl_glDeleteSync, (void *)gogl_stub_glDeleteSync, 32, "GL_ARB_sync"},
+	{"glDeleteTextures", (void **)&gogl_glDeleteTextures, (void *)gogl_stub_glDeleteTextures, 11, ""},
+	{"glDeleteVertexArrays", (void **)&gogl_glDeleteVertexArrays, (void *)gogl_stub_glDeleteVertexArrays, 30, "GL_ARB_vertex_array_object"},
+	{"glDepthFunc", (void **)&gogl_glDepthFunc, (void *)gogl_stub_glDepthFunc, 10, ""},
+	{"glDepthMask", (void **)&gogl_glDepthMask, (void *)gogl_stub_glDepthMask, 10, ""},
+	{"glDepthRange", (void **)&gogl_glDepthRange, (void *)gogl_stub_glDepthRange, 10, ""},
+	{"glDetachShader", (void **)&gogl_glDetachShader, (void *)gogl_stub_glDetachShader, 20, ""},
+	{"glDisable", (void **)&gogl_glDisable, (void *)gogl_stub_glDisable, 10, ""},
+	{"glDisableVertexAttribArray", (void **)&gogl_glDisableVertexAttribArray, (void *)gogl_stub_glDisableVertexAttribArray, 20, ""},
+	{"glDrawArrays", (void **)&gogl_glDrawArrays, (void *)gogl_stub_glDrawArrays, 11, ""},
+	{"glDrawArraysInstanced", (void **)&gogl_glDrawArraysInstanced, (void *)gogl_stub_glDrawArraysInstanced, 31, ""},
+	{"glDrawBuffers", (void **)&gogl_glDrawBuffers, (void *)gogl_stub_glDrawBuffers, 20, ""},
+	{"glDrawElements", (void **)&gogl_glDrawElements, (void *)gogl_stub_glDrawElements, 11, ""},
+	{"glDrawElementsBaseVertex", (void **)&gogl_glDrawElementsBaseVertex, (void *)gogl_stub_glDrawElementsBaseVertex, 32, "GL_ARB_draw_elements_base_vertex"},
+	{"glDrawElementsInstanced", (void **)&gogl_glDrawElementsInstanced, (void *)gogl_stub_glDrawElementsInstanced, 31, ""},
+	{"glDrawRangeElements", (void **)&gogl_glDrawRangeElements, (void *)gogl_stub_glDrawRangeElements, 12, ""},
+	{"glEnable", (void **)&gogl_glEnable, (void *)gogl_stub_glEnable, 10, ""},
+	{"glEnableVertexAttribArray", (void **)&gogl_glEnableVertexAttribArray, (void *)gogl_stub_glEnableVertexAttribArray, 20, ""},
+	{"glFenceSync", (void **)&gogl_glFenceSync, (void *)gogl_stub_glFenceSync, 32, "GL_ARB_sync"},
+	{"glFramebufferRenderbuffer", (void **)&gogl_glFramebufferRenderbuffer, (void *)gogl_stub_glFramebufferRenderbuffer, 30, "GL_ARB_framebuffer_object"},
+	{"glFramebufferTexture2D", (void **)&gogl_glFramebufferTexture2D, (void *)gogl_stub_glFramebufferTexture2D, 30, "GL_ARB_framebuffer_object"},
+	{"glFramebufferTextureLayer", (void **)&gogl_glFramebufferTextureLayer, (void *)gogl_stub_glFramebufferTextureLayer, 30, "GL_ARB_framebuffer_object"},
+	{"glFrontFace", (void **)&gogl_glFrontFace, (void *)gogl_stub_glFrontFace, 10, ""},
+	{"glGenBuffers", (void **)&gogl_glGenBuffers, (void *)gogl_stub_glGenBuffers, 15, ""},
+	{"glGenFramebuffers", (void **)&gogl_glGenFramebuffers, (void *)gogl_stub_glGenFramebuffers, 30, "GL_ARB_framebuffer_object"},
+	{"glGenRenderbuffers", (void **)&gogl_glGenRenderbuffers, (void *)gogl_stub_glGenRenderbuffers, 30, "GL_ARB_framebuffer_object"},
+	{"glGenTextures", (void **)&gogl_glGenTextures, (void *)gogl_stub_glGenTextures, 11, ""},
+	{"glGenVertexArrays", (void **)&gogl_glGenVertexArrays, (void *)gogl_stub_glGenVertexArrays, 30, "GL_ARB_vertex_array_object"},
+	{"glGenerateMipmap", (void **)&gogl_glGenerateMipmap, (void *)gogl_stub_glGenerateMipmap, 30, "GL_ARB_framebuffer_object"},
+	{"glGetActiveAttrib", (void **)&gogl_glGetActiveAttrib, (void *)gogl_stub_glGetActiveAttrib, 20, ""},
+	{"glGetActiveUniform", (void **)&gogl_glGetActiveUniform, (void *)gogl_stub_glGetActiveUniform, 20, ""},
+	{"glGetActiveUniformBlockName", (void **)&gogl_glGetActiveUniformBlockName, (void *)gogl_stub_glGetActiveUniformBlockName, 31, "GL_ARB_uniform_buffer_object"},
+	{"glGetActiveUniformBlockiv", (void **)&gogl_glGetActiveUniformBlockiv, (void *)gogl_stub_glGetActiveUniformBlockiv, 31, "GL_ARB_uniform_buffer_object"},
+	{"glGetActiveUniformsiv", (void **)&gogl_glGetActiveUniformsiv, (void *)gogl_stub_glGetActiveUniformsiv, 31, "GL_ARB_uniform_buffer_object"},
+	{"glGetAttribLocation", (void **)&gogl_glGetAttribLocation, (void *)gogl_stub_glGetAttribLocation, 20, ""},
+	{"glGetError", (void **)&gogl_glGetError, (void *)gogl_stub_glGetError, 10, ""},
+	{"glGetIntegerv", (void **)&gogl_glGetIntegerv, (void *)gogl_stub_glGetIntegerv, 10, ""},
+	{"glGetProgramInfoLog", (void **)&gogl_glGetProgramInfoLog, (void *)gogl_stub_glGetProgramInfoLog, 20, ""},
+	{"glGetProgramiv", (void **)&gogl_glGetProgramiv, (void *)gogl_stub_glGetProgramiv, 20, ""},
+	{"glGetShaderInfoLog", (void **)&gogl_glGetShaderInfoLog, (void *)gogl_stub_glGetShaderInfoLog, 20, ""},
+	{"glGetShaderiv", (void **)&gogl_glGetShaderiv, (void *)gogl_stub_glGetShaderiv, 20, ""},
+	{"glGetString", (void **)&gogl_glGetString, (void *)gogl_stub_glGetString, 10, ""},
+	{"glGetStringi", (void **)&gogl_glGetStringi, (void *)gogl_stub_glGetStringi, 30, ""},
+	{"glGetUniformLocation", (void **)&gogl_glGetUniformLocation, (void *)gogl_stub_glGetUniformLocation, 20, ""},
+	{"glLineWidth", (void **)&gogl_glLineWidth, (void *)gogl_stub_glLineWidth, 10, ""},
+	{"glLinkProgram", (void **)&gogl_glLinkProgram, (void *)gogl_stub_glLinkProgram, 20, ""},
+	{"glMapBufferRange", (void **)&gogl_glMapBufferRange, (void *)gogl_stub_glMapBufferRange, 30, "GL_ARB_map_buffer_range"},
+	{"glObjectLabel", (void **)&gogl_glObjectLabel, (void *)gogl_stub_glObjectLabel, 43, "GL_KHR_debug"},
+	{"glPixelStorei", (void **)&gogl_glPixelStorei, (void *)gogl_stub_glPixelStorei, 10, ""},
+	{"glPolygonMode", (void **)&gogl_glPolygonMode, (void *)gogl_stub_glPolygonMode, 10, ""},
+	{"glPolygonOffset", (void **)&gogl_glPolygonOffset, (void *)gogl_stub_glPolygonOffset, 11, ""},
+	{"glPopDebugGroup", (void **)&gogl_glPopDebugGroup, (void *)gogl_stub_glPopDebugGroup, 43, "GL_KHR_debug"},
+	{"glPushDebugGroup", (void **)&gogl_glPushDebugGroup, (void *)gogl_stub_glPushDebugGroup, 43, "GL_KHR_debug"},
+	{"glReadBuffer", (void **)&gogl_glReadBuffer, (void *)gogl_stub_glReadBuffer, 10, ""},
+	{"glReadPixels", (void **)&gogl_glReadPixels, (void *)gogl_stub_glReadPixels, 10, ""},
+	{"glRenderbufferStorageMultisample", (void **)&gogl_glRenderbufferStorageMultisample, (void *)gogl_stub_glRenderbufferStorageMultisample, 30, "GL_ARB_framebuffer_object"},
+	{"glScissor", (void **)&gogl_glScissor, (void *)gogl_stub_glScissor, 10, ""},
+	{"glShaderSource", (void **)&gogl_glShaderSource, (void *)gogl_stub_glShaderSource, 20, ""},
+	{"glStencilFunc", (void **)&gogl_glStencilFunc, (void *)gogl_stub_glStencilFunc, 10, ""},
+	{"glStencilFuncSeparate", (void **)&gogl_glStencilFuncSeparate, (void *)gogl_stub_glStencilFuncSeparate, 20, ""},
+	{"glStencilMask", (void **)&gogl_glStencilMask, (void *)gogl_stub_glStencilMask, 10, ""},
+	{"glStencilMaskSeparate", (void **)&gogl_glStencilMaskSeparate, (void *)gogl_stub_glStencilMaskSeparate, 20, ""},
+	{"glStencilOp", (void **)&gogl_glStencilOp, (void *)gogl_stub_glStencilOp, 10, ""},
+	{"glStencilOpSeparate", (void **)&gogl_glStencilOpSeparate, (void *)gogl_stub_glStencilOpSeparate, 20, ""},
+	{"glTexImage2D", (void **)&gogl_glTexImage2D, (void *)gogl_stub_glTexImage2D, 10, ""},
+	{"glTexImage3D", (void **)&gogl_glTexImage3D, (void *)gogl_stub_glTexImage3D, 12, ""},
+	{"glTexParameteri", (void **)&gogl_glTexParameteri, (void *)gogl_stub_glTexParameteri, 10, ""},
+	{"glTexSubImage2D", (void **)&gogl_glTexSubImage2D, (void *)gogl_stub_glTexSubImage2D, 11, ""},
+	{"glTexSubImage3D", (void **)&gogl_glTexSubImage3D, (void *)gogl_stub_glTexSubImage3D, 12, ""},
+	{"glUniform1f", (void **)&gogl_glUniform1f, (void *)gogl_stub_glUniform1f, 20, ""},
+	{"glUniform1i", (void **)&gogl_glUniform1i, (void *)gogl_stub_glUniform1i, 20, ""},
+	{"glUniform2f", (void **)&gogl_glUniform2f, (void *)gogl_stub_glUniform2f, 20, ""},
+	{"glUniform2i", (void **)&gogl_glUniform2i, (void *)gogl_stub_glUniform2i, 20, ""},
+	{"glUniform3f", (void **)&gogl_glUniform3f, (void *)gogl_stub_glUniform3f, 20, ""},
+	{"glUniform3i", (void **)&gogl_glUniform3i, (void *)gogl_stub_glUniform3i, 20, ""},
+	{"glUniform4f", (void **)&gogl_glUniform4f, (void *)gogl_stub_glUniform4f, 20, ""},
+	{"glUniform4i", (void **)&gogl_glUniform4i, (void *)gogl_stub_glUniform4i, 20, ""},
+	{"glUniformBlockBinding", (void **)&gogl_glUniformBlockBinding, (void *)gogl_stub_glUniformBlockBinding, 31, "GL_ARB_uniform_buffer_object"},
+	{"glUniformMatrix2fv", (void **)&gogl_glUniformMatrix2fv, (void *)gogl_stub_glUniformMatrix2fv, 20, ""},
+	{"glUniformMatrix2x3fv", (void **)&gogl_glUniformMatrix2x3fv, (void *)gogl_stub_glUniformMatrix2x3fv, 21, ""},
+	{"glUniformMatrix2x4fv", (void **)&gogl_glUniformMatrix2x4fv, (void *)gogl_stub_glUniformMatrix2x4fv, 21, ""},
+	{"glUniformMatrix3fv", (void **)&gogl_glUniformMatrix3fv, (void *)gogl_stub_glUniformMatrix3fv, 20, ""},
+	{"glUniformMatrix3x2fv", (void **)&gogl_glUniformMatrix3x2fv, (void *)gogl_stub_glUniformMatrix3x2fv, 21, ""},
+	{"glUniformMatrix3x4fv", (void **)&gogl_glUniformMatrix3x4fv, (void *)gogl_stub_glUniformMatrix3x4fv, 21, ""},
+	{"glUniformMatrix4fv", (void **)&gogl_glUniformMatrix4fv, (void *)gogl_stub_glUniformMatrix4fv, 20, ""},
+	{"glUniformMatrix4x2fv", (void **)&gogl_glUniformMatrix4x2fv, (void *)gogl_stub_glUniformMatrix4x2fv, 21, ""},
+	{"glUniformMatrix4x3fv", (void **)&gogl_glUniformMatrix4x3fv, (void *)gogl_stub_glUniformMatrix4x3fv, 21, ""},
+	{"glUnmapBuffer", (void **)&gogl_glUnmapBuffer, (void *)gogl_stub_glUnmapBuffer, 15, ""},
+	{"glUseProgram", (void **)&gogl_glUseProgram, (void *)gogl_stub_glUseProgram, 20, ""},
+	{"glVertexAttribDivisor", (void **)&gogl_glVertexAttribDivisor, (void *)gogl_stub_glVertexAttribDivisor, 33, ""},
+	{"glVertexAttribPointer", (void **)&gogl_glVertexAttribPointer, (void *)gogl_stub_glVertexAttribPointer, 20, ""},
+	{"glViewport", (void **)&gogl_glViewport, (void *)gogl_stub_glViewport, 10, ""},
+};
@@ -0,0 +1,466 @@
+/* Code generated by glgen from gl.xml; DO NOT EDIT. */
+
+#ifndef GOGL_FUNCS_H
+#define GOGL_FUNCS_H
+
+#ifndef APIENTRY
+#ifdef _WIN32
+#define APIENTRY __stdcall
+#else
+#define APIENTRY
+#endif
+#endif
+#ifndef APIENTRYP
+#define APIENTRYP APIENTRY *
+#endif
+
+#include <stddef.h>
+#include <stdint.h>
+typedef int8_t khronos_int8_t;
+typedef uint8_t khronos_uint8_t;
+typedef int16_t khronos_int16_t;
+typedef uint16_t khronos_uint16_t;
+typedef int32_t khronos_int32_t;
+typedef uint32_t khronos_uint32_t;
+typedef int64_t khronos_int64_t;
+typedef uint64_t khronos_uint64_t;
+typedef intptr_t khronos_intptr_t;
+typedef uintptr_t khronos_uintptr_t;
+typedef ptrdiff_t khronos_ssize_t;
+typedef size_t khronos_usize_t;
+typedef float khronos_float_t;
+typedef unsigned int GLenum;
+typedef unsigned char GLboolean;
+typedef unsigned int GLbitfield;
+typedef void GLvoid;
+typedef khronos_int8_t GLbyte;
+typedef khronos_uint8_t GLubyte;
+typedef khronos_int16_t GLshort;
+typedef khronos_uint16_t GLushort;
+typedef int GLint;
+typedef unsigned int GLuint;
+typedef int GLsizei;
+typedef khronos_float_t GLfloat;
+typedef khronos_float_t GLclampf;
+typedef double GLdouble;
+typedef double GLclampd;
+typedef char GLchar;
+typedef khronos_uint16_t GLhalf;
+typedef khronos_int32_t GLfixed;
+typedef khronos_intptr_t GLintptr;
+typedef khronos_ssize_t GLsizeiptr;
+typedef khronos_int64_t GLint64;
+typedef khronos_uint64_t GLuint64;
+typedef struct __GLsync *GLsync;
+typedef void (APIENTRY *GLDEBUGPROC)(GLenum source,GLenum type,GLuint id,GLenum severity,GLsizei length,const GLchar *message,const void *userParam);
+typedef void (APIENTRY *GLDEBUGPROCARB)(GLenum source,GLenum type,GLuint id,GLenum severity,GLsizei length,const GLchar *message,const void *userParam);
+
+typedef void (APIENTRYP GOGLPROC_glActiveTexture)(GLenum texture);
+extern GOGLPROC_glActiveTexture gogl_glActiveTexture;
+#define glActiveTexture (*gogl_glActiveTexture)
+typedef void (APIENTRYP GOGLPROC_glAttachShader)(GLuint program, GLuint shader);
+extern GOGLPROC_glAttachShader gogl_glAttachShader;
+#define glAttachShader (*gogl_glAttachShader)
+typedef void (APIENTRYP GOGLPROC_glBindBuffer)(GLenum target, GLuint buffer);
+extern GOGLPROC_glBindBuffer gogl_glBindBuffer;
+#define glBindBuffer (*gogl_glBindBuffer)
+typedef void (APIENTRYP GOGLPROC_glBindBufferBase)(GLenum target, GLuint index, GLuint buffer);
+extern GOGLPROC_glBindBufferBase gogl_glBindBufferBase;
+#define glBindBufferBase (*gogl_glBindBufferBase)
+typedef void (APIENTRYP GOGLPROC_glBindBufferRange)(GLenum target, GLuint index, GLuint buffer, GLintptr offset, GLsizeiptr size);
+extern GOGLPROC_glBindBufferRange gogl_glBindBufferRange;
+#define glBindBufferRange (*gogl_glBindBufferRange)
+typedef void (APIENTRYP GOGLPROC_glBindFramebuffer)(GLenum target, GLuint framebuffer);
+extern GOGLPROC_glBindFramebuffer gogl_glBindFramebuffer;
+#define glBindFramebuffer (*gogl_glBindFramebuffer)
+typedef void (APIENTRYP GOGLPROC_glBindRenderbuffer)(GLenum target, GLuint renderbuffer);
+extern GOGLPROC_glBindRenderbuffer gogl_glBindRenderbuffer;
+#define glBindRenderbuffer (*gogl_glBindRenderbuffer)
+typedef void (APIENTRYP GOGLPROC_glBindTexture)(GLenum target, GLuint texture);
+extern GOGLPROC_glBindTexture gogl_glBindTexture;
+#define glBindTexture (*gogl_glBindTexture)
+typedef void (APIENTRYP GOGLPROC_glBindVertexArray)(GLuint array);
+extern GOGLPROC_glBindVertexArray gogl_glBindVertexArray;
+#define glBindVertexArray (*gogl_glBindVertexArray)
+typedef void (APIENTRYP GOGLPROC_glBlendColor)(GLfloat red, GLfloat green, GLfloat blue, GLfloat alpha);
+extern GOGLPROC_glBlendColor gogl_glBlendColor;
+#define glBlendColor (*gogl_glBlendColor)
+typedef void (APIENTRYP GOGLPROC_glBlendEquation)(GLenum mode);
+extern GOGLPROC_glBlendEquation gogl_glBlendEquation;
+#define glBlendEquation (*gogl_glBlendEquation)
+typedef void (APIENTRYP GOGLPROC_glBlendEquationSeparate)(GLenum modeRGB, GLenum modeAlpha);
+extern GOGLPROC_glBlendEquationSeparate gogl_glBlendEquationSeparate;
+#define glBlendEquationSeparate (*gogl_glBlendEquationSeparate)
+typedef void (APIENTRYP GOGLPROC_glBlendFunc)(GLenum sfactor, GLenum dfactor);
+extern GOGLPROC_glBlendFunc gogl_glBlendFunc;
+#define glBlendFunc (*gogl_glBlendFunc)
+typedef void (APIENTRYP GOGLPROC_glBlendFuncSeparate)(GLenum sfactorRGB, GLenum dfactorRGB, GLenum sfactorAlpha, GLenum dfactorAlpha);
+extern GOGLPROC_glBlendFuncSeparate gogl_glBlendFuncSeparate;
+#define glBlendFuncSeparate (*gogl_glBlendFuncSeparate)
+typedef void (APIENTRYP GOGLPROC_glBufferData)(GLenum target, GLsizeiptr size, const void *data, GLenum usage);
+extern GOGLPROC_glBufferData gogl_glBufferData;
+#define glBufferData (*gogl_glBufferData)
+typedef void (APIENTRYP GOGLPROC_glBufferSubData)(GLenum target, GLintptr offset, GLsizeiptr size, const void *data);
+extern GOGLPROC_glBufferSubData gogl_glBufferSubData;
+#define glBufferSubData (*gogl_glBufferSubData)
+typedef GLenum (APIENTRYP GOGLPROC_glCheckFramebufferStatus)(GLenum target);
+extern GOGLPROC_glCheckFramebufferStatus gogl_glCheckFramebufferStatus;
+#define glCheckFramebufferStatus (*gogl_glCheckFramebufferStatus)
+typedef void (APIENTRYP GOGLPROC_glClear)(GLbitfield mask);
+extern GOGLPROC_glClear gogl_glClear;
+#define glClear (*gogl_glClear)
+typedef void (APIENTRYP GOGLPROC_glClearColor)(GLfloat red, GLfloat green, GLfloat blue, GLfloat alpha);
+extern GOGLPROC_glClearColor gogl_glClearColor;
+#define glClearColor (*gogl_glClearColor)
+typedef void (APIENTRYP GOGLPROC_glClearDepth)(GLdouble depth);
+extern GOGLPROC_glClearDepth gogl_glClearDepth;
+#define glClearDepth (*gogl_glClearDepth)
+typedef void (APIENTRYP GOGLPROC_glClearStencil)(GLint s);
+extern GOGLPROC_glClearStencil gogl_glClearStencil;
+#define glClearStencil (*gogl_glClearStencil)
+typedef GLenum (APIENTRYP GOGLPROC_glClientWaitSync)(GLsync sync, GLbitfield flags, GLuint64 timeout);
+extern GOGLPROC_glClientWaitSync gogl_glClientWaitSync;
+#define glClientWaitSync (*gogl_glClientWaitSync)
+typedef void (APIENTRYP GOGLPROC_glClipControl)(GLenum origin, GLenum depth);
+extern GOGLPROC_glClipControl gogl_glClipControl;
+#define glClipControl (*gogl_glClipControl)
+typedef void (APIENTRYP GOGLPROC_glColorMask)(GLboolean red, GLboolean green, GLboolean blue, GLboolean alpha);
+extern GOGLPROC_glColorMask gogl_glColorMask;
+#define glColorMask (*gogl_glColorMask)
+typedef void (APIENTRYP GOGLPROC_glCompileShader)(GLuint shader);
+extern GOGLPROC_glCompileShader gogl_glCompileShader;
+#define glCompileShader (*gogl_glCompileShader)
+typedef GLuint (APIENTRYP GOGLPROC_glCreateProgram)(void);
+extern GOGLPROC_glCreateProgram gogl_glCreateProgram;
+#define glCreateProgram (*gogl_glCreateProgram)
+typedef GLuint (APIENTRYP GOGLPROC_glCreateShader)(GLenum type);
+extern GOGLPROC_glCreateShader gogl_glCreateShader;
+#define glCreateShader (*gogl_glCreateShader)
+typedef void (APIENTRYP GOGLPROC_glCullFace)(GLenum mode);
+extern GOGLPROC_glCullFace gogl_glCullFace;
+#define glCullFace (*gogl_glCullFace)
+typedef void (APIENTRYP GOGLPROC_glDebugMessageCallback)(GLDEBUGPROC callback, const void *userParam);
+extern GOGLPROC_glDebugMessageCallback gogl_glDebugMessageCallback;
+#define glDebugMessageCallback (*gogl_glDebugMessageCallback)
+typedef void (APIENTRYP GOGLPROC_glDebugMessageCallbackARB)(GLDEBUGPROCARB callback, const void *userParam);
+extern GOGLPROC_glDebugMessageCallbackARB gogl_glDebugMessageCallbackARB;
+#define glDebugMessageCallbackARB (*gogl_glDebugMessageCallbackARB)
+typedef void (APIENTRYP GOGLPROC_glDebugMessageControl)(GLenum source, GLenum type, GLenum severity, GLsizei count, const GLuint *ids, GLboolean enabled);
+extern GOGLPROC_glDebugMessageControl gogl_glDebugMessageControl;
+#define glDebugMessageControl (*gogl_glDebugMessageControl)
+typedef void (APIENTRYP GOGLPROC_glDebugMessageControlARB)(GLenum source, GLenum type, GLenum severity, GLsizei count, const GLuint *ids, GLboolean enabled);
+extern GOGLPROC_glDebugMessageControlARB gogl_glDebugMessageControlARB;
+#define glDebugMessageControlARB (*gogl_glDebugMessageControlARB)
+typedef void (APIENTRYP GOGLPROC_glDebugMessageInsert)(GLenum source, GLenum type, GLuint id, GLenum severity, GLsizei length, const GLchar *buf);
+extern GOGLPROC_glDebugMessageInsert gogl_glDebugMessageInsert;
+#define glDebugMessageInsert (*gogl_glDebugMessageInsert)
+typedef void (APIENTRYP GOGLPROC_glDebugMessageInsertARB)(GLenum source, GLenum type, GLuint id, GLenum severity, GLsizei length, const GLchar *buf);
+extern GOGLPROC_glDebugMessageInsertARB gogl_glDebugMessageInsertARB;
+#define glDebugMessageInsertARB (*gogl_glDebugMessageInsertARB)
+typedef void (APIENTRYP GOGLPROC_glDeleteBuffers)(GLsizei n, const GLuint *buffers);
+extern GOGLPROC_glDeleteBuffers gogl_glDeleteBuffers;
+#define glDeleteBuffers (*gogl_glDeleteBuffers)
+typedef void (APIENTRYP GOGLPROC_glDeleteFramebuffers)(GLsizei n, const GLuint *framebuffers);
+extern GOGLPROC_glDeleteFramebuffers gogl_glDeleteFramebuffers;
+#define glDeleteFramebuffers (*gogl_glDeleteFramebuffers)
+typedef void (APIENTRYP GOGLPROC_glDeleteProgram)(GLuint program);
+extern GOGLPROC_glDeleteProgram gogl_glDeleteProgram;
+#define glDeleteProgram (*gogl_glDeleteProgram)
+typedef void (APIENTRYP GOGLPROC_glDeleteRenderbuffers)(GLsizei n, const GLuint *renderbuffers);
+extern GOGLPROC_glDeleteRenderbuffers gogl_glDeleteRenderbuffers;
+#define glDeleteRenderbuffers (*gogl_glDeleteRenderbuffers)
+typedef void (APIENTRYP GOGLPROC_glDeleteShader)(GLuint shader);
+extern GOGLPROC_glDeleteShader gogl_glDeleteShader;
+#define glDeleteShader (*gogl_glDeleteShader)
+typedef void (APIENTRYP GOGLPROC_glDeleteSync)(GLsync sync);
+extern GOGLPROC_glDeleteSync gogl_glDeleteSync;
+#define glDeleteSync (*gogl_glDeleteSync)
+typedef void (APIENTRYP GOGLPROC_glDeleteTextures)(GLsizei n, const GLuint *textures);
+extern GOGLPROC_glDeleteTextures gogl_glDeleteTextures;
+#define glDeleteTextures (*gogl_glDeleteTextures)
+typedef void (APIENTRYP GOGLPROC_glDeleteVertexArrays)(GLsizei n, const GLuint *arrays);
+extern GOGLPROC_glDeleteVertexArrays gogl_glDeleteVertexArrays;
+#define glDeleteVertexArrays (*gogl_glDeleteVertexArrays)
+typedef void (APIENTRYP GOGLPROC_glDepthFunc)(GLenum func);
+extern GOGLPROC_glDepthFunc gogl_glDepthFunc;
+#define glDepthFunc (*gogl_glDepthFunc)
+typedef void (APIENTRYP GOGLPROC_glDepthMask)(GLboolean flag);
+extern GOGLPROC_glDepthMask gogl_glDepthMask;
+#define glDepthMask (*gogl_glDepthMask)
+typedef void (APIENTRYP GOGLPROC_glDepthRange)(GLdouble n, GLdouble f);
+extern GOGLPROC_glDepthRange gogl_glDepthRange;
+#define glDepthRange (*gogl_glDepthRange)
+typedef void (APIENTRYP GOGLPROC_glDetachShader)(GLuint program, GLuint shader);
+extern GOGLPROC_glDetachShader gogl_glDetachShader;
+#define glDetachShader (*gogl_glDetachShader)
+typedef void (APIENTRYP GOGLPROC_glDisable)(GLenum cap);
+extern GOGLPROC_glDisable gogl_glDisable;
+#define glDisable (*gogl_glDisable)
+typedef void (APIENTRYP GOGLPROC_glDisableVertexAttribArray)(GLuint index);
+extern GOGLPROC_glDisableVertexAttribArray gogl_glDisableVertexAttribArray;
+#define glDisableVertexAttribArray (*gogl_glDisableVertexAttribArray)
+typedef void (APIENTRYP GOGLPROC_glDrawArrays)(GLenum mode, GLint first, GLsizei count);
+extern GOGLPROC_glDrawArrays gogl_glDrawArrays;
+#define glDrawArrays (*gogl_glDrawArrays)
+typedef void (APIENTRYP GOGLPROC_glDrawArraysInstanced)(GLenum mode, GLint first, GLsizei count, GLsizei instancecount);
+extern GOGLPROC_glDrawArraysInstanced gogl_glDrawArraysInstanced;
+#define glDrawArraysInstanced (*gogl_glDrawArraysInstanced)
+typedef void (APIENTRYP GOGLPROC_glDrawBuffers)(GLsizei n, const GLenum *bufs);
+extern GOGLPROC_glDrawBuffers gogl_glDrawBuffers;
+#define glDrawBuffers (*gogl_glDrawBuffers)
+typedef void (APIENTRYP GOGLPROC_glDrawElements)(GLenum mode, GLsizei count, GLenum type, const void *indices);
+extern GOGLPROC_glDrawElements gogl_glDrawElements;
+#define glDrawElements (*gogl_glDrawElements)
+typedef void (APIENTRYP GOGLPROC_glDrawElementsBaseVertex)(GLenum mode, GLsizei count, GLenum type, const void *indices, GLint basevertex);
+extern GOGLPROC_glDrawElementsBaseVertex gogl_glDrawElementsBaseVertex;
+#define glDrawElementsBaseVertex (*gogl_glDrawElementsBaseVertex)
+typedef void (APIENTRYP GOGLPROC_glDrawElementsInstanced)(GLenum mode, GLsizei count, GLenum type, const void *indices, GLsizei instancecount);
+extern GOGLPROC_glDrawElementsInstanced gogl_glDrawElementsInstanced;
+#define glDrawElementsInstanced (*gogl_glDrawElementsInstanced)
+typedef void (APIENTRYP GOGLPROC_glDrawRangeElements)(GLenum mode, GLuint start, GLuint end, GLsizei count, GLenum type, const void *indices);
+extern GOGLPROC_glDrawRangeElements gogl_glDrawRangeElements;
+#define glDrawRangeElements (*gogl_glDrawRangeElements)
+typedef void (APIENTRYP GOGLPROC_glEnable)(GLenum cap);
+extern GOGLPROC_glEnable gogl_glEnable;
+#define glEnable (*gogl_glEnable)
+typedef void (APIENTRYP GOGLPROC_glEnableVertexAttribArray)(GLuint index);
+extern GOGLPROC_glEnableVertexAttribArray gogl_glEnableVertexAttribArray;
+#define glEnableVertexAttribArray (*gogl_glEnableVertexAttribArray)
+typedef GLsync (APIENTRYP GOGLPROC_glFenceSync)(GLenum condition, GLbitfield flags);
+extern GOGLPROC_glFenceSync gogl_glFenceSync;
+#define glFenceSync (*gogl_glFenceSync)
+typedef void (APIENTRYP GOGLPROC_glFramebufferRenderbuffer)(GLenum target, GLenum attachment, GLenum renderbuffertarget, GLuint renderbuffer);
+extern GOGLPROC_glFramebufferRenderbuffer gogl_glFramebufferRenderbuffer;
+#define glFramebufferRenderbuffer (*gogl_glFramebufferRenderbuffer)
+typedef void (APIENTRYP GOGLPROC_glFramebufferTexture2D)(GLenum target, GLenum attachment, GLenum textarget, GLuint texture, GLint level);
+extern GOGLPROC_glFramebufferTexture2D gogl_glFramebufferTexture2D;
+#define glFramebufferTexture2D (*gogl_glFramebufferTexture2D)
+typedef void (APIENTRYP GOGLPROC_glFramebufferTextureLayer)(GLenum target, GLenum attachment, GLuint texture, GLint level, GLint layer);
+extern GOGLPROC_glFramebufferTextureLayer gogl_glFramebufferTextureLayer;
+#define glFramebufferTextureLayer (*gogl_glFramebufferTextureLayer)
+typedef void (APIENTRYP GOGLPROC_glFrontFace)(GLenum mode);
+extern GOGLPROC_glFrontFace gogl_glFrontFace;
+#define glFrontFace (*gogl_glFrontFace)
+typedef void (APIENTRYP GOGLPROC_glGenBuffers)(GLsizei n, GLuint *buffers);
+extern GOGLPROC_glGenBuffers gogl_glGenBuffers;
+#define glGenBuffers (*gogl_glGenBuffers)
+typedef void (APIENTRYP GOGLPROC_glGenFramebuffers)(GLsizei n, GLuint *framebuffers);
+extern GOGLPROC_glGenFramebuffers gogl_glGenFramebuffers;
+#define glGenFramebuffers (*gogl_glGenFramebuffers)
+typedef void (APIENTRYP GOGLPROC_glGenRenderbuffers)(GLsizei n, GLuint *renderbuffers);
+extern GOGLPROC_glGenRenderbuffers gogl_glGenRenderbuffers;
+#define glGenRenderbuffers (*gogl_glGenRenderbuffers)
+typedef void (APIENTRYP GOGLPROC_glGenTextures)(GLsizei n, GLuint *textures);
+extern GOGLPROC_glGenTextures gogl_glGenTextures;
+#define glGenTextures (*gogl_glGenTextures)
+typedef void (APIENTRYP GOGLPROC_glGenVertexArrays)(GLsizei n, GLuint *arrays);
+extern GOGLPROC_glGenVertexArrays gogl_glGenVertexArrays;
+#define glGenVertexArrays (*gogl_glGenVertexArrays)
+typedef void (APIENTRYP GOGLPROC_glGenerateMipmap)(GLenum target);
+extern GOGLPROC_glGenerateMipmap gogl_glGenerateMipmap;
+#define glGenerateMipmap (*gogl_glGenerateMipmap)
+typedef void (APIENTRYP GOGLPROC_glGetActiveAttrib)(GLuint program, GLuint index, GLsizei bufSize, GLsizei *length, GLint *size, GLenum *type, GLchar *name);
+extern GOGLPROC_glGetActiveAttrib gogl_glGetActiveAttrib;
+#define glGetActiveAttrib (*gogl_glGetActiveAttrib)
+typedef void (APIENTRYP GOGLPROC_glGetActiveUniform)(GLuint program, GLuint index, GLsizei bufSize, GLsizei *length, GLint *size, GLenum *type, GLchar *name);
+extern GOGLPROC_glGetActiveUniform gogl_glGetActiveUniform;
+#define glGetActiveUniform (*gogl_glGetActiveUniform)
+typedef void (APIENTRYP GOGLPROC_glGetActiveUniformBlockName)(GLuint program, GLuint uniformBlockIndex, GLsizei bufSize, GLsizei *length, GLchar *uniformBlockName);
+extern GOGLPROC_glGetActiveUniformBlockName gogl_glGetActiveUniformBlockName;
+#define glGetActiveUniformBlockName (*gogl_glGetActiveUniformBlockName)
+typedef void (APIENTRYP GOGLPROC_glGetActiveUniformBlockiv)(GLuint program, GLuint uniformBlockIndex, GLenum pname, GLint *params);
+extern GOGLPROC_glGetActiveUniformBlockiv gogl_glGetActiveUniformBlockiv;
+#define glGetActiveUniformBlockiv (*gogl_glGetActiveUniformBlockiv)
+typedef void (APIENTRYP GOGLPROC_glGetActiveUniformsiv)(GLuint program, GLsizei uniformCount, const GLuint *uniformIndices, GLenum pname, GLint *params);
+extern GOGLPROC_glGetActiveUniformsiv gogl_glGetActiveUniformsiv;
+#define glGetActiveUniformsiv (*gogl_glGetActiveUniformsiv)
+typedef GLint (APIENTRYP GOGLPROC_glGetAttribLocation)(GLuint program, const GLchar *name);
+extern GOGLPROC_glGetAttribLocation gogl_glGetAttribLocation;
+#define glGetAttribLocation (*gogl_glGetAttribLocation)
+typedef GLenum (APIENTRYP GOGLPROC_glGetError)(void);
+extern GOGLPROC_glGetError gogl_glGetError;
+#define glGetError (*gogl_glGetError)
+typedef void (APIENTRYP GOGLPROC_glGetIntegerv)(GLenum pname, GLint *data);
+extern GOGLPROC_glGetIntegerv gogl_glGetIntegerv;
+#define glGetIntegerv (*gogl_glGetIntegerv)
+typedef void (APIENTRYP GOGLPROC_glGetProgramInfoLog)(GLuint program, GLsizei bufSize, GLsizei *length, GLchar *infoLog);
+extern GOGLPROC_glGetProgramInfoLog gogl_glGetProgramInfoLog;
+#define glGetProgramInfoLog (*gogl_glGetProgramInfoLog)
+typedef void (APIENTRYP GOGLPROC_glGetProgramiv)(GLuint program, GLenum pname, GLint *params);
+extern GOGLPROC_glGetProgramiv gogl_glGetProgramiv;
+#define glGetProgramiv (*gogl_glGetProgramiv)
+typedef void (APIENTRYP GOGLPROC_glGetShaderInfoLog)(GLuint shader, GLsizei bufSize, GLsizei *length, GLchar *infoLog);
+extern GOGLPROC_glGetShaderInfoLog gogl_glGetShaderInfoLog;
+#define glGetShaderInfoLog (*gogl_glGetShaderInfoLog)
+typedef void (APIENTRYP GOGLPROC_glGetShaderiv)(GLuint shader, GLenum pname, GLint *params);
+extern GOGLPROC_glGetShaderiv gogl_glGetShaderiv;
+#define glGetShaderiv (*gogl_glGetShaderiv)
+typedef const GLubyte * (APIENTRYP GOGLPROC_glGetString)(GLenum name);
+extern GOGLPROC_glGetString gogl_glGetString;
+#define glGetString (*gogl_glGetString)
+typedef const GLubyte * (APIENTRYP GOGLPROC_glGetStringi)(GLenum name, GLuint index);
+extern GOGLPROC_glGetStringi gogl_glGetStringi;
+#define glGetStringi (*gogl_glGetStringi)
+typedef GLint (APIENTRYP GOGLPROC_glGetUniformLocation)(GLuint program, const GLchar *name);
+extern GOGLPROC_glGetUniformLocation gogl_glGetUniformLocation;
+#define glGetUniformLocation (*gogl_glGetUniformLocation)
+typedef void (APIENTRYP GOGLPROC_glLineWidth)(GLfloat width);
+extern GOGLPROC_glLineWidth gogl_glLineWidth;
+#define glLineWidth (*gogl_glLineWidth)
+typedef void (APIENTRYP GOGLPROC_glLinkProgram)(GLuint program);
+extern GOGLPROC_glLinkProgram gogl_glLinkProgram;
+#define glLinkProgram (*gogl_glLinkProgram)
+typedef void * (APIENTRYP GOGLPROC_glMapBufferRange)(GLenum target, GLintptr offset, GLsizeiptr length, GLbitfield access);
+extern GOGLPROC_glMapBufferRange gogl_glMapBufferRange;
+#define glMapBufferRange (*gogl_glMapBufferRange)
+typedef void (APIENTRYP GOGLPROC_glObjectLabel)(GLenum identifier, GLuint name, GLsizei length, const GLchar *label);
+extern GOGLPROC_glObjectLabel gogl_glObjectLabel;
+#define glObjectLabel (*gogl_glObjectLabel)
+typedef void (APIENTRYP GOGLPROC_glPixelStorei)(GLenum pname, GLint param);
+extern GOGLPROC_glPixelStorei gogl_glPixelStorei;
+#define glPixelStorei (*gogl_glPixelStorei)
+typedef void (APIENTRYP GOGLPROC_glPolygonMode)(GLenum face, GLenum mode);
+extern GOGLPROC_glPolygonMode gogl_glPolygonMode;
+#define glPolygonMode (*gogl_glPolygonMode)
+typedef void (APIENTRYP GOGLPROC_glPolygonOffset)(GLfloat factor, GLfloat units);
+extern GOGLPROC_glPolygonOffset gogl_glPolygonOffset;
+#define glPolygonOffset (*gogl_glPolygonOffset)
+typedef void (APIENTRYP GOGLPROC_glPopDebugGroup)(void);
+extern GOGLPROC_glPopDebugGroup gogl_glPopDebugGroup;
+#define glPopDebugGroup (*gogl_glPopDebugGroup)
+typedef void (APIENTRYP GOGLPROC_glPushDebugGroup)(GLenum source, GLuint id, GLsizei length, const GLchar *message);
+extern GOGLPROC_glPushDebugGroup gogl_glPushDebugGroup;
+#define glPushDebugGroup (*gogl_glPushDebugGroup)
+typedef void (APIENTRYP GOGLPROC_glReadBuffer)(GLenum src);
+extern GOGLPROC_glReadBuffer gogl_glReadBuffer;
+#define glReadBuffer (*gogl_glReadBuffer)
+typedef void (APIENTRYP GOGLPROC_glReadPixels)(GLint x, GLint y, GLsizei width, GLsizei height, GLenum format, GLenum type, void *pixels);
+extern GOGLPROC_glReadPixels gogl_glReadPixels;
+#define glReadPixels (*gogl_glReadPixels)
+typedef void (APIENTRYP GOGLPROC_glRenderbufferStorageMultisample)(GLenum target, GLsizei samples, GLenum internalformat, GLsizei width, GLsizei height);
+extern GOGLPROC_glRenderbufferStorageMultisample gogl_glRenderbufferStorageMultisample;
+#define glRenderbufferStorageMultisample (*gogl_glRenderbufferStorageMultisample)
+typedef void (APIENTRYP GOGLPROC_glScissor)(GLint x, GLint y, GLsizei width, GLsizei height);
+extern GOGLPROC_glScissor gogl_glScissor;
+#define glScissor (*gogl_glScissor)
+typedef void (APIENTRYP GOGLPROC_glShaderSource)(GLuint shader, GLsizei count, const GLchar *const*string, const GLint *length);
+extern GOGLPROC_glShaderSource gogl_glShaderSource;
+#define glShaderSource (*gogl_glShaderSource)
+typedef void (APIENTRYP GOGLPROC_glStencilFunc)(GLenum func, GLint ref, GLuint mask);
+extern GOGLPROC_glStencilFunc gogl_glStencilFunc;
+#define glStencilFunc (*gogl_glStencilFunc)
+typedef void (APIENTRYP GOGLPROC_glStencilFuncSeparate)(GLenum face, GLenum func, GLint ref, GLuint mask);
+extern GOGLPROC_glStencilFuncSeparate gogl_glStencilFuncSeparate;
+#define glStencilFuncSeparate (*gogl_glStencilFuncSeparate)
+typedef void (APIENTRYP GOGLPROC_glStencilMask)(GLuint mask);
+extern GOGLPROC_glStencilMask gogl_glStencilMask;
+#define glStencilMask (*gogl_glStencilMask)
+typedef void (APIENTRYP GOGLPROC_glStencilMaskSeparate)(GLenum face, GLuint mask);
+extern GOGLPROC_glStencilMaskSeparate gogl_glStencilMaskSeparate;
+#define glStencilMaskSeparate (*gogl_glStencilMaskSeparate)
+typedef void (APIENTRYP GOGLPROC_glStencilOp)(GLenum fail, GLenum zfail, GLenum zpass);
+extern GOGLPROC_glStencilOp gogl_glStencilOp;
+#define glStencilOp (*gogl_glStencilOp)
+typedef void (APIENTRYP GOGLPROC_glStencilOpSeparate)(GLenum face, GLenum sfail, GLenum dpfail, GLenum dppass);
+extern GOGLPROC_glStencilOpSeparate gogl_glStencilOpSeparate;
+#define glStencilOpSeparate (*gogl_glStencilOpSeparate)
+typedef void (APIENTRYP GOGLPROC_glTexImage2D)(GLenum target, GLint level, GLint internalformat, GLsizei width, GLsizei height, GLint border, GLenum format, GLenum type, const void *pixels);
+extern GOGLPROC_glTexImage2D gogl_glTexImage2D;
+#define glTexImage2D (*gogl_glTexImage2D)
+typedef void (APIENTRYP GOGLPROC_glTexImage3D)(GLenum target, GLint level, GLint internalformat, GLsizei width, GLsizei height, GLsizei depth, GLint border, GLenum format, GLenum type, const void *pixels);
+extern GOGLPROC_glTexImage3D gogl_glTexImage3D;
+#define glTexImage3D (*gogl_glTexImage3D)
+typedef void (APIENTRYP GOGLPROC_glTexParameteri)(GLenum target, GLenum pname, GLint param);
+extern GOGLPROC_glTexParameteri gogl_glTexParameteri;
+#define glTexParameteri (*gogl_glTexParameteri)
+typedef void (APIENTRYP GOGLPROC_glTexSubImage2D)(GLenum target, GLint level, GLint xoffset, GLint yoffset, GLsizei width, GLsizei height, GLenum format, GLenum type, const void *pixels);
+extern GOGLPROC_glTexSubImage2D gogl_glTexSubImage2D;
+#define glTexSubImage2D (*gogl_glTexSubImage2D)
+typedef void (APIENTRYP GOGLPROC_glTexSubImage3D)(GLenum target, GLint level, GLint xoffset, GLint yoffset, GLint zoffset, GLsizei width, GLsizei height, GLsizei depth, GLenum format, GLenum type, const void *pixels);
+extern GOGLPROC_glTexSubImage3D gogl_glTexSubImage3D;
+#define glTexSubImage3D (*gogl_glTexSubImage3D)
+typedef void (APIENTRYP GOGLPROC_glUniform1f)(GLint location, GLfloat v0);
+extern GOGLPROC_glUniform1f gogl_glUniform1f;
+#define glUniform1f (*gogl_glUniform1f)
+typedef void (APIENTRYP GOGLPROC_glUniform1i)(GLint location, GLint v0);
+extern GOGLPROC_glUniform1i gogl_glUniform1i;
+#define glUniform1i (*gogl_glUniform1i)
+typedef void (APIENTRYP GOGLPROC_glUniform2f)(GLint location, GLfloat v0, GLfloat v1);
+extern GOGLPROC_glUniform2f gogl_glUniform2f;
+#define glUniform2f (*gogl_glUniform2f)
+typedef void (APIENTRYP GOGLPROC_glUniform2i)(GLint location, GLint v0, GLint v1);
+extern GOGLPROC_glUniform2i gogl_glUniform2i;
+#define glUniform2i (*gogl_glUniform2i)
+typedef void (APIENTRYP GOGLPROC_glUniform3f)(GLint location, GLfloat v0, GLfloat v1, GLfloat v2);
+extern GOGLPROC_glUniform3f gogl_glUniform3f;
+#define glUniform3f (*gogl_glUniform3f)
+typedef void (APIENTRYP GOGLPROC_glUniform3i)(GLint location, GLint v0, GLint v1, GLint v2);
+extern GOGLPROC_glUniform3i gogl_glUniform3i;
+#define glUniform3i (*gogl_glUniform3i)
+typedef void (APIENTRYP GOGLPROC_glUniform4f)(GLint location, GLfloat v0, GLfloat v1, GLfloat v2, GLfloat v3);
+extern GOGLPROC_glUniform4f gogl_glUniform4f;
+#define glUniform4f (*gogl_glUniform4f)
+typedef void (APIENTRYP GOGLPROC_glUniform4i)(GLint location, GLint v0, GLint v1, GLint v2, GLint v3);
+extern GOGLPROC_glUniform4i gogl_glUniform4i;
+#define glUniform4i (*gogl_glUniform4i)
+typedef void (APIENTRYP GOGLPROC_glUniformBlockBinding)(GLuint program, GLuint uniformBlockIndex, GLuint uniformBlockBinding);
+extern GOGLPROC_glUniformBlockBinding gogl_glUniformBlockBinding;
+#define glUniformBlockBinding (*gogl_glUniformBlockBinding)
+typedef void (APIENTRYP GOGLPROC_glUniformMatrix2fv)(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value);
+extern GOGLPROC_glUniformMatrix2fv gogl_glUniformMatrix2fv;
+#define glUniformMatrix2fv (*gogl_glUniformMatrix2fv)
+typedef void (APIENTRYP GOGLPROC_glUniformMatrix2x3fv)(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value);
+extern GOGLPROC_glUniformMatrix2x3fv gogl_glUniformMatrix2x3fv;
+#define glUniformMatrix2x3fv (*gogl_glUniformMatrix2x3fv)
+typedef void (APIENTRYP GOGLPROC_glUniformMatrix2x4fv)(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value);
+extern GOGLPROC_glUniformMatrix2x4fv gogl_glUniformMatrix2x4fv;
+#define glUniformMatrix2x4fv (*gogl_glUniformMatrix2x4fv)
+typedef void (APIENTRYP GOGLPROC_glUniformMatrix3fv)(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value);
+extern GOGLPROC_glUniformMatrix3fv gogl_glUniformMatrix3fv;
+#define glUniformMatrix3fv (*gogl_glUniformMatrix3fv)
+typedef void (APIENTRYP GOGLPROC_glUniformMatrix3x2fv)(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value);
+extern GOGLPROC_glUniformMatrix3x2fv gogl_glUniformMatrix3x2fv;
+#define glUniformMatrix3x2fv (*gogl_glUniformMatrix3x2fv)
+typedef void (APIENTRYP GOGLPROC_glUniformMatrix3x4fv)(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value);
+extern GOGLPROC_glUniformMatrix3x4fv gogl_glUniformMatrix3x4fv;
+#define glUniformMatrix3x4fv (*gogl_glUniformMatrix3x4fv)
+typedef void (APIENTRYP GOGLPROC_glUniformMatrix4fv)(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value);
+extern GOGLPROC_glUniformMatrix4fv gogl_glUniformMatrix4fv;
+#define glUniformMatrix4fv (*gogl_glUniformMatrix4fv)
+typedef void (APIENTRYP GOGLPROC_glUniformMatrix4x2fv)(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value);
+extern GOGLPROC_glUniformMatrix4x2fv gogl_glUniformMatrix4x2fv;
+#define glUniformMatrix4x2fv (*gogl_glUniformMatrix4x2fv)
+typedef void (APIENTRYP GOGLPROC_glUniformMatrix4x3fv)(GLint location, GLsizei count, GLboolean transpose, const GLfloat *value);
+extern GOGLPROC_glUniformMatrix4x3fv gogl_glUniformMatrix4x3fv;
+#define glUniformMatrix4x3fv (*gogl_glUniformMatrix4x3fv)
+typedef GLboolean (APIENTRYP GOGLPROC_glUnmapBuffer)(GLenum target);
+extern GOGLPROC_glUnmapBuffer gogl_glUnmapBuffer;
+#define glUnmapBuffer (*gogl_glUnmapBuffer)
+typedef void (APIENTRYP GOGLPROC_glUseProgram)(GLuint program);
+extern GOGLPROC_glUseProgram gogl_glUseProgram;
+#define glUseProgram (*gogl_glUseProgram)
+typedef void (APIENTRYP GOGLPROC_glVertexAttribDivisor)(GLuint index, GLuint divisor);
+extern GOGLPROC_glVertexAttribDivisor gogl_glVertexAttribDivisor;
+#define glVertexAttribDivisor (*gogl_glVertexAttribDivisor)
+typedef void (APIENTRYP GOGLPROC_glVertexAttribPointer)(GLuint index, GLint size, GLenum type, GLboolean normalized, GLsizei stride, const void *pointer);
+extern GOGLPROC_glVertexAttribPointer gogl_glVertexAttribPointer;
+#define glVertexAttribPointer (*gogl_glVertexAttribPointer)
+typedef void (APIENTRYP GOGLPROC_glViewport)(GLint x, GLint y, GLsizei width, GLsizei height);
+extern GOGLPROC_glViewport gogl_glViewport;
+#define glViewport (*gogl_glViewport)
+
+struct gogl_func {
+	const char *name;
+	void **ptr;
+	void *stub;
+	int version;	/* e.g. 43 for 4.3, 0 if only extensions provide the function */
+	const char *extensions;	/* space-separated */
+};
+
+#define GOGL_NFUNCS 131
+extern struct gogl_func gogl_funcs[GOGL_NFUNCS];
+
+/* gogl_unsupported is called by the stubs with the name of the function. */
+extern void gogl_unsupported(const char *name);
+
+#endif
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// goGroups maps the types of package gl to the registry groups of their constants.
+// A constant is given the type if it belongs to exactly one of these types and is not listed in shared, and remains untyped otherwise.
+var goGroups = []struct {
+	typ     string
+	doc     string
+	groups  []string
+	bitmask bool
+}{
+	{"Capability", "a server-side capability switched with Enable and Disable.", []string{"EnableCap"}, false},
+	{"BlendFactor", "a source or destination factor for BlendFunc.", []string{"BlendingFactor", "BlendingFactorSrc", "BlendingFactorDest"}, false},
+	{"BufferTarget", "a binding point for buffer objects.", []string{"BufferTargetARB"}, false},
+	{"BufferUsage", "a hint about how the contents of a buffer are accessed.", []string{"BufferUsageARB"}, false},
+	{"PrimitiveMode", "the kind of primitives assembled by the draw calls.", []string{"PrimitiveType"}, false},
+	{"TextureTarget", "a binding point for textures or, for the cube map faces, an image of a cube map texture.", []string{"TextureTarget"}, false},
+	{"FramebufferTarget", "a binding point for framebuffer objects.", []string{"FramebufferTarget"}, false},
+	{"ShaderType", "the stage of a shader object.", []string{"ShaderType"}, false},
+	{"CompareFunc", "a comparison function, e.g. for the depth test.", []string{"DepthFunction", "StencilFunction"}, false},
+	{"ClearMask", "a combination of the buffers cleared by Clear.", []string{"ClearBufferMask"}, true},
+	{"StencilAction", "an action applied to the stencil buffer by StencilOp.", []string{"StencilOp"}, false},
+	{"BlendOp", "an equation combining the source and destination colors, see BlendEquation.", []string{"BlendEquationModeEXT"}, false},
+	{"Winding", "the orientation of front-facing polygons, see FrontFace.", []string{"FrontFaceDirection"}, false},
+	{"Face", "a selection of polygon faces, e.g. for CullFace.", []string{"TriangleFace", "CullFaceMode"}, false},
+}
+
+// shared lists constants that remain untyped since they are used in several roles, e.g. ZERO as a blend factor and a stencil action, or BACK as a face and a draw buffer.
+var shared = map[string]bool{
+	"GL_ZERO":           true,
+	"GL_ONE":            true,
+	"GL_NONE":           true,
+	"GL_TEXTURE_BUFFER": true,
+	"GL_FRONT":          true,
+	"GL_BACK":           true,
+	"GL_FRONT_AND_BACK": true,
+}
+
+// goName removes the GL_ prefix unless the name would start with a digit.
+func goName(name string) string {
+	n := strings.TrimPrefix(name, "GL_")
+	if n == "" || n[0] >= '0' && n[0] <= '9' {
+		return name
+	}
+	return n
+}
+
+func goValue(e *xmlEnum) (string, error) {
+	v, err := strconv.ParseUint(e.Value, 0, 64)
+	if err != nil {
+		// negative values such as -1
+		i, err := strconv.ParseInt(e.Value, 0, 64)
+		if err != nil {
+			return "", fmt.Errorf("%s: invalid value %q", e.Name, e.Value)
+		}
+		return strconv.FormatInt(i, 10), nil
+	}
+	if v == 0 {
+		return "0", nil
+	}
+	return fmt.Sprintf("%#x", v), nil
+}
+
+// sortKey orders names like the original gl_defs.go, where a name precedes the longer names it is a prefix of only if those continue with an underscore, e.g. ADD_SIGNED before ADD.
+func sortKey(n string) string {
+	if strings.HasPrefix(n, "GL_") {
+		return "\x00" + n
+	}
+	return strings.Replace(n, "_", " ", -1) + "~"
+}
+
+type constant struct {
+	name, value string
+}
+
+// writeDefs writes gl_defs.go, which defines the selected enums and those outside the selection whose Go names are in used, and enums.go, which defines the types of goGroups.
+func writeDefs(r *registry, sel *selection, used map[string]bool, dir string) error {
+	var untyped []constant
+	typed := make([][]constant, len(goGroups))
+	members := make([][]constant, len(goGroups))
+	seen := make(map[string]bool)
+	for _, es := range r.Enums {
+		if es.Namespace != "GL" {
+			continue
+		}
+		for i := range es.Enums {
+			e := r.enums[es.Enums[i].Name]
+			if !sel.enums[e.Name] && !used[goName(e.Name)] || seen[e.Name] || e.Value == "" {
+				continue
+			}
+			seen[e.Name] = true
+			v, err := goValue(e)
+			if err != nil {
+				return err
+			}
+			c := constant{goName(e.Name), v}
+			var in []int
+			for gi, g := range goGroups {
+				if hasAny(e.groups, g.groups) {
+					in = append(in, gi)
+				}
+			}
+			for _, gi := range in {
+				members[gi] = append(members[gi], c)
+			}
+			if len(in) == 1 && !shared[e.Name] {
+				typed[in[0]] = append(typed[in[0]], c)
+			} else {
+				untyped = append(untyped, c)
+			}
+		}
+	}
+	sort.Slice(untyped, func(i, j int) bool {
+		return sortKey(untyped[i].name) < sortKey(untyped[j].name)
+	})
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by glgen from gl.xml; DO NOT EDIT.\n\npackage gl\n\nconst (\n")
+	for _, c := range untyped {
+		fmt.Fprintf(&b, "\t%s = %s\n", c.name, c.value)
+	}
+	fmt.Fprintf(&b, ")\n")
+	for gi, g := range goGroups {
+		if len(typed[gi]) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\nconst (\n")
+		for _, c := range typed[gi] {
+			fmt.Fprintf(&b, "\t%s %s = %s\n", c.name, g.typ, c.value)
+		}
+		fmt.Fprintf(&b, ")\n")
+	}
+	if err := writeGo(filepath.Join(dir, "gl_defs.go"), b.Bytes()); err != nil {
+		return err
+	}
+
+	b.Reset()
+	fmt.Fprintf(&b, "// Code generated by glgen from gl.xml; DO NOT EDIT.\n\npackage gl\n\nimport \"fmt\"\nimport \"strings\"\n")
+	for gi, g := range goGroups {
+		// aliases share a value, which may only appear once as a map key
+		var names []constant
+		values := make(map[string]bool)
+		for _, c := range members[gi] {
+			if !values[c.value] {
+				values[c.value] = true
+				names = append(names, c)
+			}
+		}
+		m := strings.ToLower(g.typ[:1]) + g.typ[1:] + "Names"
+		v := strings.ToLower(g.typ[:1])
+		fmt.Fprintf(&b, "\n// The type %s is %s\ntype %s int\n\nvar %s = map[%s]string{\n", g.typ, g.doc, g.typ, m, g.typ)
+		for _, c := range names {
+			fmt.Fprintf(&b, "\t%s: %q,\n", c.name, c.name)
+		}
+		fmt.Fprintf(&b, "}\n\n")
+		if g.bitmask {
+			var bits []string
+			for _, c := range names {
+				bits = append(bits, c.name)
+			}
+			fmt.Fprintf(&b, `func (%[1]s %[2]s) String() string {
+	var s []string
+	for _, b := range []%[2]s{%[3]s} {
+		if %[1]s&b != 0 {
+			s = append(s, %[4]s[b])
+			%[1]s &^= b
+		}
+	}
+	if %[1]s != 0 || len(s) == 0 {
+		s = append(s, fmt.Sprintf("%%#x", int(%[1]s)))
+	}
+	return strings.Join(s, "|")
+}
+`, v, g.typ, strings.Join(bits, ", "), m)
+		} else {
+			fmt.Fprintf(&b, `func (%[1]s %[2]s) String() string {
+	if n, ok := %[3]s[%[1]s]; ok {
+		return n
+	}
+	return fmt.Sprintf("%[2]s(%%#x)", int(%[1]s))
+}
+`, v, g.typ, m)
+		}
+	}
+	return writeGo(filepath.Join(dir, "enums.go"), b.Bytes())
+}
+
+func hasAny(a []string, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func writeGo(path string, src []byte) error {
+	out, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return os.WriteFile(path, out, 0666)
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const funcsPrologue = `/* Code generated by glgen from gl.xml; DO NOT EDIT. */
+
+#ifndef GOGL_FUNCS_H
+#define GOGL_FUNCS_H
+
+#ifndef APIENTRY
+#ifdef _WIN32
+#define APIENTRY __stdcall
+#else
+#define APIENTRY
+#endif
+#endif
+#ifndef APIENTRYP
+#define APIENTRYP APIENTRY *
+#endif
+
+`
+
+// khrplatform replaces the include of KHR/khrplatform.h, so that the package needs no GL headers.
+const khrplatform = `#include <stddef.h>
+#include <stdint.h>
+typedef int8_t khronos_int8_t;
+typedef uint8_t khronos_uint8_t;
+typedef int16_t khronos_int16_t;
+typedef uint16_t khronos_uint16_t;
+typedef int32_t khronos_int32_t;
+typedef uint32_t khronos_uint32_t;
+typedef int64_t khronos_int64_t;
+typedef uint64_t khronos_uint64_t;
+typedef intptr_t khronos_intptr_t;
+typedef uintptr_t khronos_uintptr_t;
+typedef ptrdiff_t khronos_ssize_t;
+typedef size_t khronos_usize_t;
+typedef float khronos_float_t;`
+
+var callRE = regexp.MustCompile(`\bC\.(gl[A-Z][A-Za-z0-9_]*)`)
+var generatedRE = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+// scanPackage returns the sorted names of the commands called by the Go files in dir and the identifiers they do not declare themselves, which include the constants they use.
+// Test files and generated files are skipped.
+func scanPackage(dir string) ([]string, map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+	seen := make(map[string]bool)
+	idents := make(map[string]bool)
+	var names []string
+	fset := token.NewFileSet()
+	for _, f := range files {
+		if strings.HasSuffix(f, "_test.go") {
+			continue
+		}
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return nil, nil, err
+		}
+		if generatedRE.Match(b) {
+			continue
+		}
+		for _, m := range callRE.FindAllSubmatch(b, -1) {
+			if n := string(m[1]); !seen[n] {
+				seen[n] = true
+				names = append(names, n)
+			}
+		}
+		file, err := parser.ParseFile(fset, f, b, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && id.Obj == nil {
+				idents[id.Name] = true
+			}
+			return true
+		})
+	}
+	sort.Strings(names)
+	return names, idents, nil
+}
+
+// origin returns the first version of api providing the command, or 0 if no version does, and the extensions providing it.
+func (r *registry) origin(name string, api string, profile string) (int, []string) {
+	has := func(req []xmlRequire) bool {
+		for _, q := range req {
+			if q.API != "" && q.API != api || q.Profile != "" && q.Profile != profile {
+				continue
+			}
+			for _, c := range q.Commands {
+				if c.Name == name {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	version := 0
+	for _, f := range r.Features {
+		if f.API != api || !has(f.Require) {
+			continue
+		}
+		if v, err := parseVersion(f.Number); err == nil && (version == 0 || v < version) {
+			version = v
+		}
+	}
+	supported := api
+	if api == "gl" && profile == "core" {
+		supported = "glcore"
+	}
+	var exts []string
+	for _, e := range r.Extensions {
+		if strings.Contains("|"+e.Supported+"|", "|"+supported+"|") && has(e.Require) {
+			exts = append(exts, e.Name)
+		}
+	}
+	return version, exts
+}
+
+// writeFuncs writes glfuncs.h, which declares a function pointer for each used command and defines the command's name to call through it, and glfuncs.c, which defines the pointers and the table gogl_funcs used to load them.
+// Until it is loaded, a pointer refers to a stub that passes the command's name to gogl_unsupported and returns zero.
+// Used commands outside the selection are written as well; their version and extensions in the table let the loader treat them as unsupported where the context lacks them.
+func writeFuncs(r *registry, used []string, dir string) error {
+	for _, n := range used {
+		if r.commands[n] == nil {
+			return fmt.Errorf("%s is called but not defined by the registry", n)
+		}
+	}
+	var h bytes.Buffer
+	h.WriteString(funcsPrologue)
+	for _, t := range r.Types {
+		if t.API != "" && t.API != *apiFlag {
+			continue
+		}
+		s := text(t.Inner)
+		if t.Name == "khrplatform" {
+			s = khrplatform
+		}
+		if s != "" {
+			fmt.Fprintf(&h, "%s\n", s)
+		}
+	}
+	h.WriteString("\n")
+	rets := make(map[string]string)
+	params := make(map[string]string)
+	for _, n := range used {
+		c := r.commands[n]
+		rets[n] = strings.TrimSpace(strings.TrimSuffix(text(c.Proto.Inner), n))
+		var ps []string
+		for _, p := range c.Params {
+			ps = append(ps, text(p.Inner))
+		}
+		if len(ps) == 0 {
+			ps = []string{"void"}
+		}
+		params[n] = strings.Join(ps, ", ")
+		fmt.Fprintf(&h, "typedef %s (APIENTRYP GOGLPROC_%s)(%s);\n", rets[n], n, params[n])
+		fmt.Fprintf(&h, "extern GOGLPROC_%s gogl_%s;\n", n, n)
+		fmt.Fprintf(&h, "#define %s (*gogl_%s)\n", n, n)
+	}
+	fmt.Fprintf(&h, `
+struct gogl_func {
+	const char *name;
+	void **ptr;
+	void *stub;
+	int version;	/* e.g. 43 for 4.3, 0 if only extensions provide the function */
+	const char *extensions;	/* space-separated */
+};
+
+#define GOGL_NFUNCS %d
+extern struct gogl_func gogl_funcs[GOGL_NFUNCS];
+
+/* gogl_unsupported is called by the stubs with the name of the function. */
+extern void gogl_unsupported(const char *name);
+
+#endif
+`, len(used))
+	if err := os.WriteFile(filepath.Join(dir, "glfuncs.h"), h.Bytes(), 0666); err != nil {
+		return err
+	}
+
+	var c bytes.Buffer
+	c.WriteString("/* Code generated by glgen from gl.xml; DO NOT EDIT. */\n\n#include \"glfuncs.h\"\n")
+	for _, n := range used {
+		fmt.Fprintf(&c, "\nstatic %s APIENTRY gogl_stub_%s(%s)\n{\n\tgogl_unsupported(\"%s\");\n", rets[n], n, params[n], n)
+		if rets[n] != "void" {
+			fmt.Fprintf(&c, "\treturn (%s)0;\n", rets[n])
+		}
+		fmt.Fprintf(&c, "}\nGOGLPROC_%s gogl_%s = gogl_stub_%s;\n", n, n, n)
+	}
+	c.WriteString("\nstruct gogl_func gogl_funcs[GOGL_NFUNCS] = {\n")
+	for _, n := range used {
+		v, exts := r.origin(n, *apiFlag, *profileFlag)
+		fmt.Fprintf(&c, "\t{\"%s\", (void **)&gogl_%s, (void *)gogl_stub_%s, %d, \"%s\"},\n", n, n, n, v, strings.Join(exts, " "))
+	}
+	c.WriteString("};\n")
+	return os.WriteFile(filepath.Join(dir, "glfuncs.c"), c.Bytes(), 0666)
+}
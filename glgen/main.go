@@ -0,0 +1,71 @@
+// The command glgen generates the constant and function definitions of package gl from the Khronos OpenGL registry, gl.xml.
+// It is run by go generate in the package directory.
+//
+// The registry is vendored as glgen/gl.xml, an excerpt of https://github.com/KhronosGroup/OpenGL-Registry/blob/main/xml/gl.xml described by its comment.
+// glgen selects the enums and commands of an API version and profile, optionally intersected with an older version and extended by extensions, and writes
+//
+//	gl_defs.go	the constants, with their GL_ prefix removed unless they start with a digit
+//	enums.go	the types of the constant groups together with their String methods
+//	glfuncs.h	the function pointer types and variables of the commands, with macros so that glFoo calls through its pointer
+//	glfuncs.c	the function pointer variables, stubs reporting unsupported commands and the table used by the loader
+//
+// Only the commands called by the Go files of the package, i.e. the names of the form C.glFoo, are written to glfuncs.h and glfuncs.c.
+// They are written even if they are not part of the selection, as are the constants the package refers to, so that the package builds with any selection; it is an error if the registry lacks one of the commands.
+// For each command the table records the version and the extensions providing it, so that the loader can tell whether the context supports it.
+//
+// Usage:
+//
+//	glgen [-registry gl.xml] [-api gl] [-version 4.3] [-profile core] [-intersect version] [-extensions GL_ARB_foo,...] [-used dir] [-o dir]
+package main
+
+import (
+	"flag"
+	"log"
+	"strings"
+)
+
+var (
+	registryFlag   = flag.String("registry", "glgen/gl.xml", "path of gl.xml")
+	apiFlag        = flag.String("api", "gl", "API to generate, gl or gles2")
+	versionFlag    = flag.String("version", "4.3", "API version to generate")
+	profileFlag    = flag.String("profile", "core", "profile to generate, core or compatibility")
+	intersectFlag  = flag.String("intersect", "", "if set, keep only enums and commands also in this version of the compatibility profile")
+	extensionsFlag = flag.String("extensions", "", "comma-separated list of extensions to include")
+	usedFlag       = flag.String("used", ".", "directory of the Go files whose calls select the commands")
+	outFlag        = flag.String("o", ".", "output directory")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("glgen: ")
+	flag.Parse()
+	reg, err := readRegistry(*registryFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var exts []string
+	if *extensionsFlag != "" {
+		exts = strings.Split(*extensionsFlag, ",")
+	}
+	sel, err := reg.selectFeatures(*apiFlag, *versionFlag, *profileFlag, exts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *intersectFlag != "" {
+		old, err := reg.selectFeatures(*apiFlag, *intersectFlag, "compatibility", nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		sel.intersect(old)
+	}
+	used, idents, err := scanPackage(*usedFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := writeDefs(reg, sel, idents, *outFlag); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeFuncs(reg, used, *outFlag); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The types below mirror the parts of the registry schema glgen needs.
+
+type registry struct {
+	Types      []xmlType      `xml:"types>type"`
+	Groups     []xmlGroup     `xml:"groups>group"`
+	Enums      []xmlEnums     `xml:"enums"`
+	Commands   []xmlCommand   `xml:"commands>command"`
+	Features   []xmlFeature   `xml:"feature"`
+	Extensions []xmlExtension `xml:"extensions>extension"`
+
+	enums    map[string]*xmlEnum
+	commands map[string]*xmlCommand
+}
+
+type xmlType struct {
+	Name  string `xml:"name,attr"`
+	API   string `xml:"api,attr"`
+	Inner string `xml:",innerxml"`
+}
+
+// xmlGroup is the group element of older registries; newer ones use the group attribute of the enums.
+type xmlGroup struct {
+	Name  string  `xml:"name,attr"`
+	Enums []named `xml:"enum"`
+}
+
+type xmlEnums struct {
+	Namespace string    `xml:"namespace,attr"`
+	Group     string    `xml:"group,attr"`
+	Type      string    `xml:"type,attr"`
+	Enums     []xmlEnum `xml:"enum"`
+}
+
+type xmlEnum struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+	Type  string `xml:"type,attr"` // "u" or "ull" for unsigned values
+	API   string `xml:"api,attr"`
+	Group string `xml:"group,attr"` // comma-separated list of groups
+
+	groups []string
+}
+
+type xmlCommand struct {
+	Proto  xmlProto   `xml:"proto"`
+	Params []xmlProto `xml:"param"`
+}
+
+// xmlProto is the proto or a param element of a command, e.g. <param><ptype>GLenum</ptype> <name>mode</name></param>.
+type xmlProto struct {
+	Name  string `xml:"name"`
+	Inner string `xml:",innerxml"`
+}
+
+type xmlFeature struct {
+	API     string       `xml:"api,attr"`
+	Name    string       `xml:"name,attr"`
+	Number  string       `xml:"number,attr"`
+	Require []xmlRequire `xml:"require"`
+	Remove  []xmlRequire `xml:"remove"`
+}
+
+type xmlExtension struct {
+	Name      string       `xml:"name,attr"`
+	Supported string       `xml:"supported,attr"`
+	Require   []xmlRequire `xml:"require"`
+}
+
+type xmlRequire struct {
+	API      string  `xml:"api,attr"`
+	Profile  string  `xml:"profile,attr"`
+	Enums    []named `xml:"enum"`
+	Commands []named `xml:"command"`
+}
+
+type named struct {
+	Name string `xml:"name,attr"`
+}
+
+func readRegistry(path string) (*registry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r := new(registry)
+	if err := xml.Unmarshal(b, r); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	r.enums = make(map[string]*xmlEnum)
+	for i := range r.Enums {
+		es := &r.Enums[i]
+		for j := range es.Enums {
+			e := &es.Enums[j]
+			if e.Group != "" {
+				e.groups = strings.Split(e.Group, ",")
+			}
+			if es.Group != "" {
+				e.groups = append(e.groups, es.Group)
+			}
+			// enums with an api attribute override the generic definition for that api
+			if old, ok := r.enums[e.Name]; !ok || old.API == "" && e.API == *apiFlag {
+				r.enums[e.Name] = e
+			}
+		}
+	}
+	for _, g := range r.Groups {
+		for _, n := range g.Enums {
+			if e, ok := r.enums[n.Name]; ok {
+				e.groups = append(e.groups, g.Name)
+			}
+		}
+	}
+	r.commands = make(map[string]*xmlCommand)
+	for i := range r.Commands {
+		c := &r.Commands[i]
+		r.commands[c.Proto.Name] = c
+	}
+	return r, nil
+}
+
+// The type selection is the set of enums and commands of an API version.
+type selection struct {
+	enums    map[string]bool
+	commands map[string]bool
+}
+
+func (s *selection) apply(req []xmlRequire, api string, profile string, add bool) {
+	for _, r := range req {
+		if r.API != "" && r.API != api || r.Profile != "" && r.Profile != profile {
+			continue
+		}
+		for _, e := range r.Enums {
+			if add {
+				s.enums[e.Name] = true
+			} else {
+				delete(s.enums, e.Name)
+			}
+		}
+		for _, c := range r.Commands {
+			if add {
+				s.commands[c.Name] = true
+			} else {
+				delete(s.commands, c.Name)
+			}
+		}
+	}
+}
+
+func (s *selection) intersect(t *selection) {
+	for n := range s.enums {
+		if !t.enums[n] {
+			delete(s.enums, n)
+		}
+	}
+	for n := range s.commands {
+		if !t.commands[n] {
+			delete(s.commands, n)
+		}
+	}
+}
+
+// parseVersion turns "3.2" into 32.
+func parseVersion(v string) (int, error) {
+	f := strings.SplitN(v, ".", 2)
+	if len(f) != 2 {
+		return 0, fmt.Errorf("invalid version %q", v)
+	}
+	major, err1 := strconv.Atoi(f[0])
+	minor, err2 := strconv.Atoi(f[1])
+	if err1 != nil || err2 != nil {
+		return 0, fmt.Errorf("invalid version %q", v)
+	}
+	return major*10 + minor, nil
+}
+
+// selectFeatures applies the requirements and removals of all features of api up to version in the given profile, followed by those of the extensions.
+func (r *registry) selectFeatures(api string, version string, profile string, exts []string) (*selection, error) {
+	want, err := parseVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	s := &selection{make(map[string]bool), make(map[string]bool)}
+	found := false
+	for _, f := range r.Features {
+		if f.API != api {
+			continue
+		}
+		v, err := parseVersion(f.Number)
+		if err != nil {
+			return nil, fmt.Errorf("feature %s: %v", f.Name, err)
+		}
+		if v > want {
+			continue
+		}
+		found = found || v == want
+		s.apply(f.Require, api, profile, true)
+		s.apply(f.Remove, api, profile, false)
+	}
+	if !found {
+		return nil, fmt.Errorf("no feature for %s version %s", api, version)
+	}
+	supported := api
+	if api == "gl" && profile == "core" {
+		supported = "glcore"
+	}
+	for _, name := range exts {
+		var ext *xmlExtension
+		for i := range r.Extensions {
+			if r.Extensions[i].Name == name {
+				ext = &r.Extensions[i]
+			}
+		}
+		if ext == nil {
+			return nil, fmt.Errorf("unknown extension %s", name)
+		}
+		if !strings.Contains("|"+ext.Supported+"|", "|"+supported+"|") {
+			return nil, fmt.Errorf("extension %s is not supported by %s", name, supported)
+		}
+		s.apply(ext.Require, api, profile, true)
+	}
+	return s, nil
+}
+
+var tagRE = regexp.MustCompile(`<[^>]*>`)
+
+// text returns the C text of a registry element, e.g. "typedef unsigned int GLenum;" for <type>typedef unsigned int <name>GLenum</name>;</type>.
+func text(inner string) string {
+	inner = strings.Replace(inner, "<apientry/>", "APIENTRY", -1)
+	return strings.TrimSpace(html.UnescapeString(tagRE.ReplaceAllString(inner, "")))
+}
@@ -0,0 +1,79 @@
+//go:build !osmesa
+
+package headless
+
+// #cgo LDFLAGS: -lEGL
+// #include <EGL/egl.h>
+// #include <EGL/eglext.h>
+import "C"
+import "runtime"
+
+// The type Context represents a headless OpenGL context.
+type Context struct {
+	dpy C.EGLDisplay
+	ctx C.EGLContext
+}
+
+func eglError(op string) error {
+	return &Error{op, int(C.eglGetError())}
+}
+
+// New creates an OpenGL context of the given version, which uses the core profile for version 3.2 and later, and makes it current.
+// Since a context is bound to a thread, New locks the calling goroutine to its thread until Destroy is called.
+// gl.Init has to be called afterwards, as with any other context.
+func New(major int, minor int) (*Context, error) {
+	runtime.LockOSThread()
+	c, err := newContext(major, minor)
+	if err != nil {
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+	return c, nil
+}
+
+func newContext(major int, minor int) (*Context, error) {
+	c := new(Context)
+	c.dpy = C.eglGetPlatformDisplay(C.EGL_PLATFORM_SURFACELESS_MESA, nil, nil)
+	if c.dpy == 0 {
+		return nil, eglError("eglGetPlatformDisplay")
+	}
+	var ma, mi C.EGLint
+	if C.eglInitialize(c.dpy, &ma, &mi) == C.EGL_FALSE {
+		return nil, eglError("eglInitialize")
+	}
+	if C.eglBindAPI(C.EGL_OPENGL_API) == C.EGL_FALSE {
+		err := eglError("eglBindAPI")
+		C.eglTerminate(c.dpy)
+		return nil, err
+	}
+	attr := []C.EGLint{
+		C.EGL_CONTEXT_MAJOR_VERSION, C.EGLint(major),
+		C.EGL_CONTEXT_MINOR_VERSION, C.EGLint(minor),
+	}
+	if major > 3 || major == 3 && minor >= 2 {
+		attr = append(attr, C.EGL_CONTEXT_OPENGL_PROFILE_MASK, C.EGL_CONTEXT_OPENGL_CORE_PROFILE_BIT)
+	}
+	attr = append(attr, C.EGL_NONE)
+	// EGL_KHR_no_config_context: no surface is ever used, so no config is needed
+	c.ctx = C.eglCreateContext(c.dpy, 0, nil, &attr[0])
+	if c.ctx == nil {
+		err := eglError("eglCreateContext")
+		C.eglTerminate(c.dpy)
+		return nil, err
+	}
+	if C.eglMakeCurrent(c.dpy, nil, nil, c.ctx) == C.EGL_FALSE {
+		err := eglError("eglMakeCurrent")
+		C.eglDestroyContext(c.dpy, c.ctx)
+		C.eglTerminate(c.dpy)
+		return nil, err
+	}
+	return c, nil
+}
+
+// Destroy releases and destroys the context and unlocks the goroutine from its thread. It must be called on the goroutine that called New.
+func (c *Context) Destroy() {
+	C.eglMakeCurrent(c.dpy, nil, nil, nil)
+	C.eglDestroyContext(c.dpy, c.ctx)
+	C.eglTerminate(c.dpy)
+	runtime.UnlockOSThread()
+}
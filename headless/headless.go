@@ -0,0 +1,21 @@
+// The package headless creates OpenGL contexts without a window, e.g. for tests and batch rendering on machines without a display or GPU.
+// By default it uses EGL with the EGL_MESA_platform_surfaceless extension. Building with the osmesa tag selects OSMesa instead.
+// With Mesa's software rasterizer both work on GPU-less Linux machines.
+// gl.Init loads the functions of such a context with eglGetProcAddress or OSMesaGetProcAddress respectively, so neither needs GLX.
+// A headless context has no usable default framebuffer, so rendering should go to a gl.Framebuffer.
+package headless
+
+import "fmt"
+
+// The type Error represents a failure to create a context.
+type Error struct {
+	Op   string // the failing call
+	Code int    // error code reported by EGL, or 0
+}
+
+func (e *Error) Error() string {
+	if e.Code != 0 {
+		return fmt.Sprintf("headless: %s failed: error %#x", e.Op, e.Code)
+	}
+	return "headless: " + e.Op + " failed"
+}
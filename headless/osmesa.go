@@ -0,0 +1,62 @@
+//go:build osmesa
+
+package headless
+
+// #cgo LDFLAGS: -lOSMesa
+// #include <stdlib.h>
+// #include <GL/osmesa.h>
+import "C"
+import "runtime"
+import "unsafe"
+
+// The type Context represents a headless OpenGL context.
+type Context struct {
+	ctx C.OSMesaContext
+	buf unsafe.Pointer
+}
+
+// New creates an OpenGL context of the given version, which uses the core profile for version 3.2 and later, and makes it current.
+// Since a context is bound to a thread, New locks the calling goroutine to its thread until Destroy is called.
+// gl.Init has to be called afterwards, as with any other context.
+func New(major int, minor int) (*Context, error) {
+	runtime.LockOSThread()
+	c, err := newContext(major, minor)
+	if err != nil {
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+	return c, nil
+}
+
+func newContext(major int, minor int) (*Context, error) {
+	c := new(Context)
+	attr := []C.int{
+		C.OSMESA_FORMAT, C.OSMESA_RGBA,
+		C.OSMESA_CONTEXT_MAJOR_VERSION, C.int(major),
+		C.OSMESA_CONTEXT_MINOR_VERSION, C.int(minor),
+	}
+	if major > 3 || major == 3 && minor >= 2 {
+		attr = append(attr, C.OSMESA_PROFILE, C.OSMESA_CORE_PROFILE)
+	}
+	attr = append(attr, 0)
+	c.ctx = C.OSMesaCreateContextAttribs(&attr[0], nil)
+	if c.ctx == nil {
+		return nil, &Error{"OSMesaCreateContextAttribs", 0}
+	}
+	// OSMesa requires a color buffer; a single pixel suffices since rendering goes to framebuffer objects
+	c.buf = C.malloc(4)
+	if C.OSMesaMakeCurrent(c.ctx, c.buf, C.GL_UNSIGNED_BYTE, 1, 1) == C.GL_FALSE {
+		C.OSMesaDestroyContext(c.ctx)
+		C.free(c.buf)
+		return nil, &Error{"OSMesaMakeCurrent", 0}
+	}
+	return c, nil
+}
+
+// Destroy releases and destroys the context and unlocks the goroutine from its thread. It must be called on the goroutine that called New.
+func (c *Context) Destroy() {
+	C.OSMesaMakeCurrent(nil, nil, 0, 0, 0)
+	C.OSMesaDestroyContext(c.ctx)
+	C.free(c.buf)
+	runtime.UnlockOSThread()
+}
@@ -0,0 +1,95 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+import "fmt"
+import "io"
+import "runtime"
+import "sort"
+import "strings"
+
+// objectKey identifies a GL object by its type, e.g. BUFFER, and name.
+type objectKey struct {
+	typ  C.GLenum
+	name C.GLuint
+}
+
+var objectTypeNames = map[C.GLenum]string{
+	BUFFER:  "Buffer",
+	TEXTURE: "Texture",
+	PROGRAM: "Program",
+	SHADER:  "Shader",
+}
+
+// liveObjects maps the objects created while leak tracking is enabled to the program counters of their creation stack.
+var liveObjects map[objectKey][]uintptr
+
+// TrackLeaks enables or disables the leak tracker. While it is enabled, the creation stack of every Buffer, Texture, Program and Shader is recorded until the object is deleted.
+// Disabling the tracker forgets all recorded objects. Tracking is meant for tests and debugging; a test would enable it, run and clean up, and then check that Leaks returns nothing.
+func TrackLeaks(on bool) {
+	if on && liveObjects == nil {
+		liveObjects = make(map[objectKey][]uintptr)
+	} else if !on {
+		liveObjects = nil
+	}
+}
+
+func trackObject(typ C.GLenum, name C.GLuint) {
+	if liveObjects == nil {
+		return
+	}
+	pc := make([]uintptr, 32)
+	// skip runtime.Callers and trackObject
+	n := runtime.Callers(2, pc)
+	liveObjects[objectKey{typ, name}] = pc[:n]
+}
+
+func untrackObject(typ C.GLenum, name C.GLuint) {
+	if liveObjects != nil {
+		delete(liveObjects, objectKey{typ, name})
+	}
+}
+
+// The type Leak describes an object that was created while leak tracking was enabled and has not been deleted.
+type Leak struct {
+	Type  string // "Buffer", "Texture", "Program" or "Shader"
+	Name  uint32 // the GL object name
+	Stack string // the stack trace of the creation, formatted like a panic
+}
+
+func (l Leak) String() string {
+	return fmt.Sprintf("%s %d created at:\n%s", l.Type, l.Name, l.Stack)
+}
+
+// Leaks returns the tracked objects that are still alive, ordered by type and name.
+func Leaks() []Leak {
+	var leaks []Leak
+	for k, pc := range liveObjects {
+		var b strings.Builder
+		frames := runtime.CallersFrames(pc)
+		for {
+			f, more := frames.Next()
+			fmt.Fprintf(&b, "%s\n\t%s:%d\n", f.Function, f.File, f.Line)
+			if !more {
+				break
+			}
+		}
+		leaks = append(leaks, Leak{objectTypeNames[k.typ], uint32(k.name), b.String()})
+	}
+	sort.Slice(leaks, func(i, j int) bool {
+		if leaks[i].Type != leaks[j].Type {
+			return leaks[i].Type < leaks[j].Type
+		}
+		return leaks[i].Name < leaks[j].Name
+	})
+	return leaks
+}
+
+// DumpLeaks writes the objects returned by Leaks to w and returns their number.
+func DumpLeaks(w io.Writer) int {
+	leaks := Leaks()
+	for _, l := range leaks {
+		fmt.Fprintln(w, l)
+	}
+	return len(leaks)
+}
@@ -0,0 +1,157 @@
+package gl
+
+// #include "glfuncs.h"
+//
+// #ifdef _WIN32
+// #include <windows.h>
+//
+// static HMODULE gogl_lib;
+//
+// static const char *gogl_open(void) {
+// 	gogl_lib = LoadLibraryA("opengl32.dll");
+// 	return gogl_lib == NULL ? "cannot load opengl32.dll" : NULL;
+// }
+//
+// static void *gogl_getproc(const char *name) {
+// 	void *p = (void *)wglGetProcAddress(name);
+// 	// wglGetProcAddress returns small values on failure and does not load the OpenGL 1.1 functions
+// 	if ((uintptr_t)p <= 3 || p == (void *)-1)
+// 		p = (void *)GetProcAddress(gogl_lib, name);
+// 	return p;
+// }
+// #else
+// #include <dlfcn.h>
+//
+// typedef void *(*gogl_getproc_fn)(const char *);
+//
+// static void *gogl_lib;
+// static gogl_getproc_fn gogl_getproc_addr;
+//
+// #ifdef __APPLE__
+// static const char *gogl_open(void) {
+// 	gogl_lib = dlopen("/System/Library/Frameworks/OpenGL.framework/OpenGL", RTLD_LAZY | RTLD_GLOBAL);
+// 	return gogl_lib == NULL ? "cannot load the OpenGL framework" : NULL;
+// }
+// #else
+// static const char *gogl_open(void) {
+// 	// an OSMesa context is current if the program uses OSMesa and it says so
+// 	void *self = dlopen(NULL, RTLD_LAZY);
+// 	void *(*osmesa)(void) = (void *(*)(void))dlsym(self, "OSMesaGetCurrentContext");
+// 	if (osmesa != NULL && osmesa() != NULL) {
+// 		gogl_getproc_addr = (gogl_getproc_fn)dlsym(self, "OSMesaGetProcAddress");
+// 		gogl_lib = self;
+// 		return gogl_getproc_addr == NULL ? "OSMesaGetProcAddress not found" : NULL;
+// 	}
+// 	// an EGL context is current if EGL says so, a GLX context is assumed otherwise
+// 	void *egl = dlopen("libEGL.so.1", RTLD_LAZY | RTLD_GLOBAL);
+// 	if (egl != NULL) {
+// 		void *(*current)(void) = (void *(*)(void))dlsym(egl, "eglGetCurrentContext");
+// 		if (current != NULL && current() != NULL) {
+// 			gogl_getproc_addr = (gogl_getproc_fn)dlsym(egl, "eglGetProcAddress");
+// 			gogl_lib = dlopen("libOpenGL.so.0", RTLD_LAZY | RTLD_GLOBAL);
+// 			if (gogl_lib == NULL)
+// 				gogl_lib = dlopen("libGL.so.1", RTLD_LAZY | RTLD_GLOBAL);
+// 			return gogl_getproc_addr == NULL ? "eglGetProcAddress not found" : NULL;
+// 		}
+// 	}
+// 	gogl_lib = dlopen("libGL.so.1", RTLD_LAZY | RTLD_GLOBAL);
+// 	if (gogl_lib == NULL)
+// 		gogl_lib = dlopen("libGL.so", RTLD_LAZY | RTLD_GLOBAL);
+// 	if (gogl_lib == NULL)
+// 		return "cannot load libGL.so.1";
+// 	gogl_getproc_addr = (gogl_getproc_fn)dlsym(gogl_lib, "glXGetProcAddressARB");
+// 	return NULL;
+// }
+// #endif
+//
+// static void *gogl_getproc(const char *name) {
+// 	void *p = NULL;
+// 	if (gogl_getproc_addr != NULL)
+// 		p = gogl_getproc_addr(name);
+// 	if (p == NULL && gogl_lib != NULL)
+// 		p = dlsym(gogl_lib, name);
+// 	return p;
+// }
+// #endif
+//
+// static const char *gogl_missing;
+//
+// void gogl_unsupported(const char *name) {
+// 	if (gogl_missing == NULL)
+// 		gogl_missing = name;
+// }
+//
+// static const char *gogl_take_missing(void) {
+// 	const char *s = gogl_missing;
+// 	gogl_missing = NULL;
+// 	return s;
+// }
+import "C"
+import "errors"
+import "strings"
+import "unsafe"
+
+// The type UnsupportedError is returned by GetError after a function the context does not provide was called.
+// The call itself does nothing and, if the function returns a value, returns zero.
+type UnsupportedError struct {
+	Func string // e.g. "glDebugMessageCallback"
+}
+
+func (e *UnsupportedError) Error() string {
+	return e.Func + " is not supported by the context"
+}
+
+// takeUnsupported returns the first unsupported function called since the last call, or "".
+func takeUnsupported() string {
+	if s := C.gogl_take_missing(); s != nil {
+		return C.GoString(s)
+	}
+	return ""
+}
+
+// funcs returns the table of the functions called by the package, see glgen.
+func funcs() []C.struct_gogl_func {
+	return (*[C.GOGL_NFUNCS]C.struct_gogl_func)(unsafe.Pointer(&C.gogl_funcs[0]))[:]
+}
+
+// loadFuncs loads the function pointers from the platform's OpenGL library. Functions that cannot be found keep their stubs.
+func loadFuncs() error {
+	if e := C.gogl_open(); e != nil {
+		return errors.New("gl: " + C.GoString(e))
+	}
+	for _, f := range funcs() {
+		*f.ptr = f.stub
+		if p := C.gogl_getproc(f.name); p != nil {
+			*f.ptr = p
+		}
+	}
+	return nil
+}
+
+// restrictFuncs replaces the pointers of the functions that neither the context's version nor one of its extensions provides by their stubs, since the libraries return pointers for any function they know.
+func restrictFuncs() {
+	for _, f := range funcs() {
+		v := int(f.version)
+		if v != 0 && caps.AtLeast(v/10, v%10) {
+			continue
+		}
+		ok := false
+		for _, e := range strings.Fields(C.GoString(f.extensions)) {
+			ok = ok || caps.HasExtension(e)
+		}
+		if !ok {
+			*f.ptr = f.stub
+		}
+	}
+}
+
+// Supported reports whether the current context provides the function with the given name, e.g. "glDebugMessageCallback".
+// Only the functions called by the package are known; Supported returns false for the others and before Init.
+func Supported(name string) bool {
+	for _, f := range funcs() {
+		if C.GoString(f.name) == name {
+			return *f.ptr != f.stub
+		}
+	}
+	return false
+}
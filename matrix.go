@@ -86,6 +86,24 @@ func RotY(r float64) Mat4 {
 		[4]float64{0, 0, 0, 1}}
 }
 
+// RotAxis returns a rotation matrix rotating r degrees around axis, which need not be normalized, like glRotate.
+// Like RotZ and RotY, and unlike RotX, a positive angle rotates counterclockwise when looking from the positive end of the axis towards the origin.
+func RotAxis(axis Vec3, r float64) Mat4 {
+	return RotAxisRad(axis, r*deg)
+}
+
+// RotAxisRad is like RotAxis, but takes the angle in radians.
+func RotAxisRad(axis Vec3, r float64) Mat4 {
+	a := axis.Normalize()
+	x, y, z := a[0], a[1], a[2]
+	s, c := math.Sincos(r)
+	t := 1 - c
+	return Mat4{[4]float64{t*x*x + c, t*x*y - s*z, t*x*z + s*y, 0},
+		[4]float64{t*x*y + s*z, t*y*y + c, t*y*z - s*x, 0},
+		[4]float64{t*x*z - s*y, t*y*z + s*x, t*z*z + c, 0},
+		[4]float64{0, 0, 0, 1}}
+}
+
 // Translate returns a translation matrix
 func Translate(x, y, z float64) Mat4 {
 	return Mat4{[4]float64{1, 0, 0, x},
@@ -94,8 +112,8 @@ func Translate(x, y, z float64) Mat4 {
 		[4]float64{0, 0, 0, 1}}
 }
 
-// Frustum returns a projection matrix similar to gluPerspective. The arguments are field of view angle in degrees, aspect ratio and near and far z clipping plane distance.
-func Frustum(fov, aspect, zNear, zFar float64) Mat4 {
+// Perspective returns a projection matrix like gluPerspective. The arguments are field of view angle in degrees, aspect ratio and near and far z clipping plane distance.
+func Perspective(fov, aspect, zNear, zFar float64) Mat4 {
 	f := 1 / math.Tan(fov*deg/2)
 	return Mat4{[4]float64{f / aspect, 0, 0, 0},
 		[4]float64{0, f, 0, 0},
@@ -103,6 +121,66 @@ func Frustum(fov, aspect, zNear, zFar float64) Mat4 {
 		[4]float64{0, 0, -1, 0}}
 }
 
+// PerspectiveInfinite is like Perspective with the far clipping plane at infinity.
+func PerspectiveInfinite(fov, aspect, zNear float64) Mat4 {
+	f := 1 / math.Tan(fov*deg/2)
+	return Mat4{[4]float64{f / aspect, 0, 0, 0},
+		[4]float64{0, f, 0, 0},
+		[4]float64{0, 0, -1, -2 * zNear},
+		[4]float64{0, 0, -1, 0}}
+}
+
+// PerspectiveReversedZ is like Perspective, but maps the near plane to depth 1 and the far plane to depth 0, which distributes the precision of a floating point depth buffer more evenly.
+// It is meant for a depth range of 0 to 1 as selected by ClipControl(LOWER_LEFT, ZERO_TO_ONE), together with DepthFunc(GREATER) and ClearDepth(0).
+func PerspectiveReversedZ(fov, aspect, zNear, zFar float64) Mat4 {
+	f := 1 / math.Tan(fov*deg/2)
+	return Mat4{[4]float64{f / aspect, 0, 0, 0},
+		[4]float64{0, f, 0, 0},
+		[4]float64{0, 0, zNear / (zFar - zNear), zFar * zNear / (zFar - zNear)},
+		[4]float64{0, 0, -1, 0}}
+}
+
+// PerspectiveInfiniteReversedZ is like PerspectiveReversedZ with the far clipping plane at infinity, which maps to depth 0.
+func PerspectiveInfiniteReversedZ(fov, aspect, zNear float64) Mat4 {
+	f := 1 / math.Tan(fov*deg/2)
+	return Mat4{[4]float64{f / aspect, 0, 0, 0},
+		[4]float64{0, f, 0, 0},
+		[4]float64{0, 0, 0, zNear},
+		[4]float64{0, 0, -1, 0}}
+}
+
+// Frustum returns a perspective projection matrix like glFrustum. The arguments are the left, right, bottom and top edges of the near clipping plane and the near and far z clipping plane distances.
+func Frustum(left, right, bottom, top, zNear, zFar float64) Mat4 {
+	return Mat4{[4]float64{2 * zNear / (right - left), 0, (right + left) / (right - left), 0},
+		[4]float64{0, 2 * zNear / (top - bottom), (top + bottom) / (top - bottom), 0},
+		[4]float64{0, 0, -(zFar + zNear) / (zFar - zNear), -2 * zFar * zNear / (zFar - zNear)},
+		[4]float64{0, 0, -1, 0}}
+}
+
+// Ortho returns an orthographic projection matrix like glOrtho.
+func Ortho(left, right, bottom, top, zNear, zFar float64) Mat4 {
+	return Mat4{[4]float64{2 / (right - left), 0, 0, -(right + left) / (right - left)},
+		[4]float64{0, 2 / (top - bottom), 0, -(top + bottom) / (top - bottom)},
+		[4]float64{0, 0, -2 / (zFar - zNear), -(zFar + zNear) / (zFar - zNear)},
+		[4]float64{0, 0, 0, 1}}
+}
+
+// Ortho2D returns an orthographic projection matrix like gluOrtho2D, i.e. Ortho with z clipping planes at -1 and 1. Ortho2D(0, w, 0, h) maps window coordinates of a w by h viewport.
+func Ortho2D(left, right, bottom, top float64) Mat4 {
+	return Ortho(left, right, bottom, top, -1, 1)
+}
+
+// LookAt returns a viewing matrix like gluLookAt, which places the eye at eye looking towards center with up pointing upwards.
+func LookAt(eye, center, up Vec3) Mat4 {
+	f := center.Sub(eye).Normalize()
+	s := f.Cross(up).Normalize()
+	u := s.Cross(f)
+	return Mat4{[4]float64{s[0], s[1], s[2], -s.Dot(eye)},
+		[4]float64{u[0], u[1], u[2], -u.Dot(eye)},
+		[4]float64{-f[0], -f[1], -f[2], f.Dot(eye)},
+		[4]float64{0, 0, 0, 1}}
+}
+
 // Scale returns a scale matrix
 func Scale(x, y, z float64) Mat4 {
 	return Mat4{[4]float64{x, 0, 0, 0},
@@ -111,61 +189,97 @@ func Scale(x, y, z float64) Mat4 {
 		[4]float64{0, 0, 0, 1}}
 }
 
-//calculate the inverse Mat4 of original Mat4
-func (m Mat4) Inverse() Mat4 {
-	var u = m[0][0]*m[1][1]*m[2][2]*m[3][3] +
-		m[0][0]*m[1][2]*m[2][3]*m[3][1] +
-		m[0][0]*m[1][3]*m[2][1]*m[3][2] +
-		m[0][1]*m[1][0]*m[2][3]*m[3][2] +
-		m[0][1]*m[1][2]*m[2][0]*m[3][3] +
-		m[0][1]*m[1][3]*m[2][2]*m[3][0] +
-		m[0][2]*m[1][0]*m[2][1]*m[3][3] +
-		m[0][2]*m[1][1]*m[2][3]*m[3][0] +
-		m[0][2]*m[1][3]*m[2][0]*m[3][1] +
-		m[0][3]*m[1][0]*m[2][2]*m[3][1] +
-		m[0][3]*m[1][1]*m[2][0]*m[3][2] +
-		m[0][3]*m[1][2]*m[2][1]*m[3][0] -
-		m[0][0]*m[1][1]*m[2][3]*m[3][2] -
-		m[0][0]*m[1][2]*m[2][1]*m[3][3] -
-		m[0][0]*m[1][3]*m[2][2]*m[3][1] -
-		m[0][1]*m[1][0]*m[2][2]*m[3][3] -
-		m[0][1]*m[1][2]*m[2][3]*m[3][0] -
-		m[0][1]*m[1][3]*m[2][0]*m[3][2] -
-		m[0][2]*m[1][0]*m[2][3]*m[3][1] -
-		m[0][2]*m[1][1]*m[2][0]*m[3][3] -
-		m[0][2]*m[1][3]*m[2][1]*m[3][0] -
-		m[0][3]*m[1][0]*m[2][1]*m[3][2] -
-		m[0][3]*m[1][1]*m[2][2]*m[3][0] -
-		m[0][3]*m[1][2]*m[2][0]*m[3][1]
-
-	var n = 1 / u
+// Determinant returns the determinant of m.
+func (m Mat4) Determinant() float64 {
+	a, b, c, d := m[0], m[1], m[2], m[3]
+	// 2x2 minors of the upper and lower two rows
+	s0 := a[0]*b[1] - a[1]*b[0]
+	s1 := a[0]*b[2] - a[2]*b[0]
+	s2 := a[0]*b[3] - a[3]*b[0]
+	s3 := a[1]*b[2] - a[2]*b[1]
+	s4 := a[1]*b[3] - a[3]*b[1]
+	s5 := a[2]*b[3] - a[3]*b[2]
+	c0 := c[0]*d[1] - c[1]*d[0]
+	c1 := c[0]*d[2] - c[2]*d[0]
+	c2 := c[0]*d[3] - c[3]*d[0]
+	c3 := c[1]*d[2] - c[2]*d[1]
+	c4 := c[1]*d[3] - c[3]*d[1]
+	c5 := c[2]*d[3] - c[3]*d[2]
+	return s0*c5 - s1*c4 + s2*c3 + s3*c2 - s4*c1 + s5*c0
+}
+
+// singularEps is the magnitude, relative to the largest element, below which a pivot counts as zero.
+const singularEps = 1e-12
 
-	return Mat4{
-		[4]float64{
-			(m[1][1]*m[2][2]*m[3][3] + m[1][2]*m[2][3]*m[3][1] + m[1][3]*m[2][1]*m[3][2] - m[1][1]*m[2][3]*m[3][2] - m[1][2]*m[2][1]*m[3][3] - m[1][3]*m[2][2]*m[3][1]) * n,
-			(m[0][1]*m[2][3]*m[3][2] + m[0][2]*m[2][1]*m[3][3] + m[1][3]*m[2][2]*m[3][1] - m[0][1]*m[2][2]*m[3][3] - m[0][2]*m[2][3]*m[3][1] - m[1][3]*m[2][1]*m[3][2]) * n,
-			(m[0][1]*m[1][2]*m[3][3] + m[0][2]*m[1][3]*m[3][1] + m[1][3]*m[1][1]*m[3][2] - m[0][1]*m[1][3]*m[3][2] - m[0][2]*m[1][1]*m[3][3] - m[1][3]*m[1][2]*m[3][1]) * n,
-			(m[0][1]*m[1][3]*m[2][2] + m[0][2]*m[1][1]*m[2][3] + m[1][3]*m[1][2]*m[2][1] - m[0][1]*m[1][2]*m[2][3] - m[0][2]*m[1][3]*m[2][1] - m[1][3]*m[1][1]*m[2][2]) * n,
-		},
-		[4]float64{
-			(m[1][0]*m[2][3]*m[3][2] + m[1][2]*m[2][0]*m[3][3] + m[1][3]*m[2][2]*m[3][0] - m[1][0]*m[2][2]*m[3][3] - m[1][2]*m[2][3]*m[3][0] - m[1][3]*m[2][0]*m[3][2]) * n,
-			(m[0][0]*m[2][2]*m[3][3] + m[0][2]*m[2][3]*m[3][0] + m[1][3]*m[2][0]*m[3][2] - m[0][0]*m[2][3]*m[3][2] - m[0][2]*m[2][0]*m[3][3] - m[1][3]*m[2][2]*m[3][0]) * n,
-			(m[0][0]*m[1][3]*m[3][2] + m[0][2]*m[1][0]*m[3][3] + m[1][3]*m[1][2]*m[3][0] - m[0][0]*m[1][2]*m[3][3] - m[0][2]*m[1][3]*m[3][0] - m[1][3]*m[1][0]*m[3][2]) * n,
-			(m[0][0]*m[1][2]*m[2][3] + m[0][2]*m[1][3]*m[2][0] + m[1][3]*m[1][0]*m[2][2] - m[0][0]*m[1][3]*m[2][2] - m[0][2]*m[1][0]*m[2][3] - m[1][3]*m[1][2]*m[2][0]) * n,
-		},
-		[4]float64{
-			(m[1][0]*m[2][1]*m[3][3] + m[1][1]*m[2][3]*m[3][0] + m[1][3]*m[2][0]*m[3][1] - m[1][0]*m[2][3]*m[3][1] - m[1][1]*m[2][0]*m[3][3] - m[1][3]*m[2][1]*m[3][0]) * n,
-			(m[0][0]*m[2][3]*m[3][1] + m[0][1]*m[2][0]*m[3][3] + m[1][3]*m[2][1]*m[3][0] - m[0][0]*m[2][1]*m[3][3] - m[0][1]*m[2][3]*m[3][0] - m[1][3]*m[2][0]*m[3][1]) * n,
-			(m[0][0]*m[1][1]*m[3][3] + m[0][1]*m[1][3]*m[3][0] + m[1][3]*m[1][0]*m[3][1] - m[0][0]*m[1][3]*m[3][1] - m[0][1]*m[1][0]*m[3][3] - m[1][3]*m[1][1]*m[3][0]) * n,
-			(m[0][0]*m[1][3]*m[2][1] + m[0][1]*m[1][0]*m[2][3] + m[1][3]*m[1][1]*m[2][0] - m[0][0]*m[1][1]*m[2][3] - m[0][1]*m[1][3]*m[2][0] - m[1][3]*m[1][0]*m[2][1]) * n,
-		},
-		[4]float64{
-			(m[1][0]*m[2][2]*m[3][1] + m[1][1]*m[2][0]*m[3][2] + m[1][2]*m[2][1]*m[3][0] - m[1][0]*m[2][1]*m[3][2] - m[1][1]*m[2][2]*m[3][0] - m[1][2]*m[2][0]*m[3][1]) * n,
-			(m[0][0]*m[2][1]*m[3][2] + m[0][1]*m[2][2]*m[3][0] + m[0][2]*m[2][0]*m[3][1] - m[0][0]*m[2][2]*m[3][1] - m[0][1]*m[2][0]*m[3][2] - m[0][2]*m[2][1]*m[3][0]) * n,
-			(m[0][0]*m[1][2]*m[3][1] + m[0][1]*m[1][0]*m[3][2] + m[0][2]*m[1][1]*m[3][0] - m[0][0]*m[1][1]*m[3][2] - m[0][1]*m[1][2]*m[3][0] - m[0][2]*m[1][0]*m[3][1]) * n,
-			(m[0][0]*m[1][1]*m[2][2] + m[0][1]*m[1][2]*m[2][0] + m[0][2]*m[1][0]*m[2][1] - m[0][0]*m[1][2]*m[2][1] - m[0][1]*m[1][0]*m[2][2] - m[0][2]*m[1][1]*m[2][0]) * n,
-		},
+// Inverse returns the inverse of m, computed by Gauss-Jordan elimination with partial pivoting.
+// It returns false and the zero matrix if m is singular or too close to singular for the result to be meaningful.
+func (m Mat4) Inverse() (Mat4, bool) {
+	scale := 0.0
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			scale = math.Max(scale, math.Abs(m[i][j]))
+		}
 	}
+	if scale == 0 {
+		return Mat4{}, false
+	}
+	r := Identity
+	for c := 0; c < 4; c++ {
+		p := c
+		for i := c + 1; i < 4; i++ {
+			if math.Abs(m[i][c]) > math.Abs(m[p][c]) {
+				p = i
+			}
+		}
+		if math.Abs(m[p][c]) <= singularEps*scale {
+			return Mat4{}, false
+		}
+		m[c], m[p] = m[p], m[c]
+		r[c], r[p] = r[p], r[c]
+		f := 1 / m[c][c]
+		for j := 0; j < 4; j++ {
+			m[c][j] *= f
+			r[c][j] *= f
+		}
+		for i := 0; i < 4; i++ {
+			if i == c || m[i][c] == 0 {
+				continue
+			}
+			f := m[i][c]
+			for j := 0; j < 4; j++ {
+				m[i][j] -= f * m[c][j]
+				r[i][j] -= f * r[c][j]
+			}
+		}
+	}
+	return r, true
+}
+
+// InverseAffine returns the inverse of an affine transformation, i.e. a matrix whose last row is 0, 0, 0, 1, which is cheaper than Inverse.
+// It returns false and the zero matrix if the upper left 3x3 part is singular. The last row of m is not checked.
+func (m Mat4) InverseAffine() (Mat4, bool) {
+	a, ok := m.Mat3().Inverse()
+	if !ok {
+		return Mat4{}, false
+	}
+	return affine(a, m), true
+}
+
+// InverseRigid returns the inverse of a rigid transformation, i.e. a rotation followed by a translation, using the transpose of the rotation.
+// The result is wrong if m contains scaling, shearing or projection.
+func (m Mat4) InverseRigid() Mat4 {
+	return affine(m.Mat3().Transpose(), m)
+}
+
+// affine returns the affine transformation with linear part a, the inverse of the linear part of m, and the translation undoing that of m.
+func affine(a Mat3, m Mat4) Mat4 {
+	var r Mat4
+	for i := 0; i < 3; i++ {
+		r[i][0], r[i][1], r[i][2] = a[i][0], a[i][1], a[i][2]
+		r[i][3] = -(a[i][0]*m[0][3] + a[i][1]*m[1][3] + a[i][2]*m[2][3])
+	}
+	r[3][3] = 1
+	return r
 }
 
 //calculate the transpose Mat4 of original Mat4
@@ -177,3 +291,251 @@ func (m Mat4) Transpose() Mat4 {
 		[4]float64{m[0][3], m[1][3], m[2][3], m[3][3]},
 	}
 }
+
+// The type Mat2 represents a double precision 2x2 matrix.
+type Mat2 [2][2]float64
+
+// The type Mat3 represents a double precision 3x3 matrix, e.g. a normal matrix.
+type Mat3 [3][3]float64
+
+// The non-square matrix types are named like in GLSL, by their number of columns and then rows, and are indexed by row and then column like Mat4.
+// E.g. Mat2x3 has 2 columns and 3 rows and corresponds to a GLSL mat2x3.
+type (
+	Mat2x3 [3][2]float64
+	Mat3x2 [2][3]float64
+	Mat2x4 [4][2]float64
+	Mat4x2 [2][4]float64
+	Mat3x4 [4][3]float64
+	Mat4x3 [3][4]float64
+)
+
+// 2x2 and 3x3 identity matrices
+var (
+	Identity2 = Mat2{{1, 0}, {0, 1}}
+	Identity3 = Mat3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+)
+
+// Mul returns the product m*n.
+func (m Mat2) Mul(n Mat2) Mat2 {
+	var r Mat2
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			r[i][j] = m[i][0]*n[0][j] + m[i][1]*n[1][j]
+		}
+	}
+	return r
+}
+
+// Apply applies the matrix to v.
+func (m Mat2) Apply(v Vec2) Vec2 {
+	return Vec2{m[0][0]*v[0] + m[0][1]*v[1], m[1][0]*v[0] + m[1][1]*v[1]}
+}
+
+// Transpose returns the transpose of m.
+func (m Mat2) Transpose() Mat2 {
+	return Mat2{{m[0][0], m[1][0]}, {m[0][1], m[1][1]}}
+}
+
+// Determinant returns the determinant of m.
+func (m Mat2) Determinant() float64 {
+	return m[0][0]*m[1][1] - m[0][1]*m[1][0]
+}
+
+// Inverse returns the inverse of m, or false and the zero matrix if m is singular or nearly so, like Mat4.Inverse.
+func (m Mat2) Inverse() (Mat2, bool) {
+	d := m.Determinant()
+	scale := math.Max(math.Max(math.Abs(m[0][0]), math.Abs(m[0][1])), math.Max(math.Abs(m[1][0]), math.Abs(m[1][1])))
+	if scale == 0 || math.Abs(d) <= singularEps*scale*scale {
+		return Mat2{}, false
+	}
+	return Mat2{{m[1][1] / d, -m[0][1] / d}, {-m[1][0] / d, m[0][0] / d}}, true
+}
+
+// Mat3 returns m as the upper left part of a 3x3 matrix that is otherwise the identity.
+func (m Mat2) Mat3() Mat3 {
+	return Mat3{{m[0][0], m[0][1], 0}, {m[1][0], m[1][1], 0}, {0, 0, 1}}
+}
+
+// Mul returns the product m*n.
+func (m Mat3) Mul(n Mat3) Mat3 {
+	var r Mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = m[i][0]*n[0][j] + m[i][1]*n[1][j] + m[i][2]*n[2][j]
+		}
+	}
+	return r
+}
+
+// Apply applies the matrix to v.
+func (m Mat3) Apply(v Vec3) Vec3 {
+	var r Vec3
+	for i := 0; i < 3; i++ {
+		r[i] = m[i][0]*v[0] + m[i][1]*v[1] + m[i][2]*v[2]
+	}
+	return r
+}
+
+// Transpose returns the transpose of m.
+func (m Mat3) Transpose() Mat3 {
+	return Mat3{
+		{m[0][0], m[1][0], m[2][0]},
+		{m[0][1], m[1][1], m[2][1]},
+		{m[0][2], m[1][2], m[2][2]},
+	}
+}
+
+// cofactors returns the matrix of the cofactors of m, i.e. the transpose of its adjugate.
+func (m Mat3) cofactors() Mat3 {
+	return Mat3{
+		{m[1][1]*m[2][2] - m[1][2]*m[2][1], m[1][2]*m[2][0] - m[1][0]*m[2][2], m[1][0]*m[2][1] - m[1][1]*m[2][0]},
+		{m[0][2]*m[2][1] - m[0][1]*m[2][2], m[0][0]*m[2][2] - m[0][2]*m[2][0], m[0][1]*m[2][0] - m[0][0]*m[2][1]},
+		{m[0][1]*m[1][2] - m[0][2]*m[1][1], m[0][2]*m[1][0] - m[0][0]*m[1][2], m[0][0]*m[1][1] - m[0][1]*m[1][0]},
+	}
+}
+
+// Determinant returns the determinant of m.
+func (m Mat3) Determinant() float64 {
+	c := m.cofactors()
+	return m[0][0]*c[0][0] + m[0][1]*c[0][1] + m[0][2]*c[0][2]
+}
+
+// Inverse returns the inverse of m, or false and the zero matrix if m is singular or nearly so, like Mat4.Inverse.
+func (m Mat3) Inverse() (Mat3, bool) {
+	c := m.cofactors()
+	d := m[0][0]*c[0][0] + m[0][1]*c[0][1] + m[0][2]*c[0][2]
+	scale := 0.0
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			scale = math.Max(scale, math.Abs(m[i][j]))
+		}
+	}
+	if scale == 0 || math.Abs(d) <= singularEps*scale*scale*scale {
+		return Mat3{}, false
+	}
+	var r Mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = c[j][i] / d
+		}
+	}
+	return r, true
+}
+
+// Mat2 returns the upper left 2x2 part of m.
+func (m Mat3) Mat2() Mat2 {
+	return Mat2{{m[0][0], m[0][1]}, {m[1][0], m[1][1]}}
+}
+
+// Mat4 returns m as the upper left part of a 4x4 matrix that is otherwise the identity.
+func (m Mat3) Mat4() Mat4 {
+	return Mat4{
+		{m[0][0], m[0][1], m[0][2], 0},
+		{m[1][0], m[1][1], m[1][2], 0},
+		{m[2][0], m[2][1], m[2][2], 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// Mat3 returns the upper left 3x3 part of m, i.e. its linear part if m is affine.
+func (m Mat4) Mat3() Mat3 {
+	return Mat3{
+		{m[0][0], m[0][1], m[0][2]},
+		{m[1][0], m[1][1], m[1][2]},
+		{m[2][0], m[2][1], m[2][2]},
+	}
+}
+
+// Mat4x3 returns the upper three rows of m, which hold all of an affine transformation.
+func (m Mat4) Mat4x3() Mat4x3 {
+	return Mat4x3{m[0], m[1], m[2]}
+}
+
+// Mat4 returns the affine transformation with the upper three rows m.
+func (m Mat4x3) Mat4() Mat4 {
+	return Mat4{m[0], m[1], m[2], {0, 0, 0, 1}}
+}
+
+// NormalMatrix returns the matrix transforming normals for the transformation m, i.e. the inverse transpose of its upper left 3x3 part.
+// If that part is singular, the matrix of its cofactors is returned, which still maps normals to the correct directions but not lengths.
+// Since the inverse transpose is only determined up to scale for this purpose, normals should be normalized after the transformation anyway.
+func (m Mat4) NormalMatrix() Mat3 {
+	a := m.Mat3()
+	c := a.cofactors()
+	d := a[0][0]*c[0][0] + a[0][1]*c[0][1] + a[0][2]*c[0][2]
+	if d == 0 {
+		return c
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			c[i][j] /= d
+		}
+	}
+	return c
+}
+
+// Transpose returns the transpose of m.
+func (m Mat2x3) Transpose() Mat3x2 {
+	var r Mat3x2
+	for i := range m {
+		for j := range m[i] {
+			r[j][i] = m[i][j]
+		}
+	}
+	return r
+}
+
+// Transpose returns the transpose of m.
+func (m Mat3x2) Transpose() Mat2x3 {
+	var r Mat2x3
+	for i := range m {
+		for j := range m[i] {
+			r[j][i] = m[i][j]
+		}
+	}
+	return r
+}
+
+// Transpose returns the transpose of m.
+func (m Mat2x4) Transpose() Mat4x2 {
+	var r Mat4x2
+	for i := range m {
+		for j := range m[i] {
+			r[j][i] = m[i][j]
+		}
+	}
+	return r
+}
+
+// Transpose returns the transpose of m.
+func (m Mat4x2) Transpose() Mat2x4 {
+	var r Mat2x4
+	for i := range m {
+		for j := range m[i] {
+			r[j][i] = m[i][j]
+		}
+	}
+	return r
+}
+
+// Transpose returns the transpose of m.
+func (m Mat3x4) Transpose() Mat4x3 {
+	var r Mat4x3
+	for i := range m {
+		for j := range m[i] {
+			r[j][i] = m[i][j]
+		}
+	}
+	return r
+}
+
+// Transpose returns the transpose of m.
+func (m Mat4x3) Transpose() Mat3x4 {
+	var r Mat3x4
+	for i := range m {
+		for j := range m[i] {
+			r[j][i] = m[i][j]
+		}
+	}
+	return r
+}
@@ -0,0 +1,159 @@
+package gl
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func maxDiff(a, b Mat4) float64 {
+	d := 0.0
+	for i := range a {
+		for j := range a[i] {
+			d = math.Max(d, math.Abs(a[i][j]-b[i][j]))
+		}
+	}
+	return d
+}
+
+func randMat4(r *rand.Rand) Mat4 {
+	var m Mat4
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] = r.Float64()*20 - 10
+		}
+	}
+	return m
+}
+
+func randAffine(r *rand.Rand) Mat4 {
+	q := QuatAxisAngle(Vec3{r.Float64() - .5, r.Float64() - .5, r.Float64() - .5}, r.Float64()*360)
+	return Mul4(Translate(r.Float64()*10, r.Float64()*10, r.Float64()*10), q.ToMat4(), Scale(r.Float64()+.1, r.Float64()+.1, 2))
+}
+
+func TestInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 10000; n++ {
+		m := randMat4(r)
+		inv, ok := m.Inverse()
+		if !ok {
+			t.Fatalf("Inverse of %v reported singular", m)
+		}
+		// the error grows with the condition of m
+		if d := maxDiff(Mul4(m, inv), Identity); d > 1e-12*(1+maxDiff(inv, Mat4{})) {
+			t.Fatalf("m*Inverse(m) differs from Identity by %g for %v", d, m)
+		}
+		if p := m.Determinant() * inv.Determinant(); math.Abs(p-1) > 1e-6 {
+			t.Fatalf("det(m)*det(Inverse(m)) = %g for %v", p, m)
+		}
+	}
+}
+
+func TestInverseSingular(t *testing.T) {
+	for _, m := range []Mat4{
+		{},
+		{{1, 2, 3, 4}, {2, 4, 6, 8}, {0, 1, 0, 1}, {1, 1, 1, 1}},
+		Scale(1, 0, 1),
+		Mul4(RotZ(30), Scale(1, 1e-14, 1)),
+	} {
+		if inv, ok := m.Inverse(); ok || inv != (Mat4{}) {
+			t.Errorf("Inverse(%v) = %v, %v; want singular", m, inv, ok)
+		}
+		if m[3] == [4]float64{0, 0, 0, 1} {
+			if _, ok := m.InverseAffine(); ok {
+				t.Errorf("InverseAffine(%v) did not report singular", m)
+			}
+		}
+	}
+	// uniformly small matrices are regular
+	if inv, ok := Scale(1e-8, 1e-8, 1e-8).Inverse(); !ok || math.Abs(inv[0][0]-1e8) > 1e-4 {
+		t.Errorf("Inverse of a small scale = %v, %v", inv, ok)
+	}
+}
+
+func TestInverseAffineRigid(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for n := 0; n < 1000; n++ {
+		m := randAffine(r)
+		inv, _ := m.Inverse()
+		aff, ok := m.InverseAffine()
+		if !ok || maxDiff(aff, inv) > 1e-12 {
+			t.Fatalf("InverseAffine(%v) = %v, %v; Inverse gives %v", m, aff, ok, inv)
+		}
+		q := QuatAxisAngle(Vec3{r.Float64() - .5, r.Float64() - .5, r.Float64() - .5}, r.Float64()*360)
+		rigid := Mul4(Translate(r.Float64()*10, r.Float64()*10, r.Float64()*10), q.ToMat4())
+		inv, _ = rigid.Inverse()
+		if d := maxDiff(rigid.InverseRigid(), inv); d > 1e-12 {
+			t.Fatalf("InverseRigid(%v) differs from Inverse by %g", rigid, d)
+		}
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	for _, c := range []struct {
+		m Mat4
+		d float64
+	}{
+		{Identity, 1},
+		{Mul4(Translate(1, 2, 3), RotZ(33), Scale(2, 3, 4)), 24},
+		{Scale(-1, 1, 1), -1},
+		{Mat4{{1, 2, 3, 4}, {2, 4, 6, 8}, {0, 1, 0, 1}, {1, 1, 1, 1}}, 0},
+	} {
+		if d := c.m.Determinant(); math.Abs(d-c.d) > 1e-12 {
+			t.Errorf("Determinant(%v) = %g, want %g", c.m, d, c.d)
+		}
+	}
+}
+
+func TestProjections(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		got  Mat4
+		want Mat4
+	}{
+		// glFrustum(-1, 3, -2, 2, 1, 5)
+		{"Frustum", Frustum(-1, 3, -2, 2, 1, 5), Mat4{{0.5, 0, 0.5, 0}, {0, 0.5, 0, 0}, {0, 0, -1.5, -2.5}, {0, 0, -1, 0}}},
+		// gluPerspective(90, 2, 1, 3)
+		{"Perspective", Perspective(90, 2, 1, 3), Mat4{{0.5, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, -2, -3}, {0, 0, -1, 0}}},
+		// glOrtho(-1, 3, -2, 2, 1, 5)
+		{"Ortho", Ortho(-1, 3, -2, 2, 1, 5), Mat4{{0.5, 0, 0, -0.5}, {0, 0.5, 0, 0}, {0, 0, -0.5, -1.5}, {0, 0, 0, 1}}},
+		// gluOrtho2D(0, 4, 0, 2)
+		{"Ortho2D", Ortho2D(0, 4, 0, 2), Mat4{{0.5, 0, 0, -1}, {0, 1, 0, -1}, {0, 0, -1, 0}, {0, 0, 0, 1}}},
+		// gluLookAt(0, 0, 5, 0, 0, 0, 0, 1, 0)
+		{"LookAt", LookAt(Vec3{0, 0, 5}, Vec3{}, Vec3{0, 1, 0}), Translate(0, 0, -5)},
+		// gluLookAt(1, 0, 0, 0, 0, 0, 0, 1, 0)
+		{"LookAt", LookAt(Vec3{1, 0, 0}, Vec3{}, Vec3{0, 2, 0}), Mat4{{0, 0, -1, 0}, {0, 1, 0, 0}, {1, 0, 0, -1}, {0, 0, 0, 1}}},
+	} {
+		if d := maxDiff(c.got, c.want); d > 1e-12 {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestDepthRange(t *testing.T) {
+	depth := func(m Mat4, dist float64) float64 {
+		v := m.Apply4([4]float64{0, 0, -dist, 1})
+		return v[2] / v[3]
+	}
+	const n, f, inf = 0.5, 100, 1e12
+	for _, c := range []struct {
+		name          string
+		m             Mat4
+		far           float64
+		atNear, atFar float64
+	}{
+		{"Perspective", Perspective(60, 1.5, n, f), f, -1, 1},
+		{"Frustum", Frustum(-1, 1, -1, 1, n, f), f, -1, 1},
+		{"Ortho", Ortho(-1, 1, -1, 1, n, f), f, -1, 1},
+		{"PerspectiveInfinite", PerspectiveInfinite(60, 1.5, n), inf, -1, 1},
+		{"PerspectiveReversedZ", PerspectiveReversedZ(60, 1.5, n, f), f, 1, 0},
+		{"PerspectiveInfiniteReversedZ", PerspectiveInfiniteReversedZ(60, 1.5, n), inf, 1, 0},
+	} {
+		if z := depth(c.m, n); math.Abs(z-c.atNear) > 1e-9 {
+			t.Errorf("%s maps the near plane to %g, want %g", c.name, z, c.atNear)
+		}
+		if z := depth(c.m, c.far); math.Abs(z-c.atFar) > 1e-9 {
+			t.Errorf("%s maps the far plane to %g, want %g", c.name, z, c.atFar)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package gl
+
+import "math"
+
+// The type Quat represents a double precision quaternion x*i + y*j + z*k + w, stored as x, y, z, w like a GLSL vec4.
+// Unit quaternions represent rotations; like RotZ, a positive angle rotates counterclockwise when looking from the positive end of the axis towards the origin.
+type Quat [4]float64
+
+// The identity rotation
+var QuatIdentity = Quat{0, 0, 0, 1}
+
+// QuatAxisAngle returns the rotation by angle degrees around axis, which need not be normalized.
+func QuatAxisAngle(axis Vec3, angle float64) Quat {
+	a := axis.Normalize()
+	s, c := math.Sincos(angle * deg / 2)
+	return Quat{a[0] * s, a[1] * s, a[2] * s, c}
+}
+
+// QuatEuler returns the rotation by x degrees around the x axis, followed by y degrees around the y axis and z degrees around the z axis.
+func QuatEuler(x, y, z float64) Quat {
+	return QuatAxisAngle(Vec3{0, 0, 1}, z).Mul(QuatAxisAngle(Vec3{0, 1, 0}, y)).Mul(QuatAxisAngle(Vec3{1, 0, 0}, x))
+}
+
+// QuatFromMat4 returns the rotation of the upper left 3x3 part of m, which must be a rotation matrix.
+func QuatFromMat4(m Mat4) Quat {
+	var q Quat
+	switch t := m[0][0] + m[1][1] + m[2][2]; {
+	case t > 0:
+		s := 2 * math.Sqrt(t+1)
+		q = Quat{(m[2][1] - m[1][2]) / s, (m[0][2] - m[2][0]) / s, (m[1][0] - m[0][1]) / s, s / 4}
+	case m[0][0] > m[1][1] && m[0][0] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[0][0]-m[1][1]-m[2][2])
+		q = Quat{s / 4, (m[0][1] + m[1][0]) / s, (m[0][2] + m[2][0]) / s, (m[2][1] - m[1][2]) / s}
+	case m[1][1] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[1][1]-m[0][0]-m[2][2])
+		q = Quat{(m[0][1] + m[1][0]) / s, s / 4, (m[1][2] + m[2][1]) / s, (m[0][2] - m[2][0]) / s}
+	default:
+		s := 2 * math.Sqrt(1+m[2][2]-m[0][0]-m[1][1])
+		q = Quat{(m[0][2] + m[2][0]) / s, (m[1][2] + m[2][1]) / s, s / 4, (m[1][0] - m[0][1]) / s}
+	}
+	return q.Normalize()
+}
+
+// Mul returns the product q*r, which rotates by r first and then by q.
+func (q Quat) Mul(r Quat) Quat {
+	return Quat{
+		q[3]*r[0] + q[0]*r[3] + q[1]*r[2] - q[2]*r[1],
+		q[3]*r[1] - q[0]*r[2] + q[1]*r[3] + q[2]*r[0],
+		q[3]*r[2] + q[0]*r[1] - q[1]*r[0] + q[2]*r[3],
+		q[3]*r[3] - q[0]*r[0] - q[1]*r[1] - q[2]*r[2],
+	}
+}
+
+// Conj returns the conjugate of q, which for a unit quaternion is the inverse rotation.
+func (q Quat) Conj() Quat {
+	return Quat{-q[0], -q[1], -q[2], q[3]}
+}
+
+// Inverse returns the multiplicative inverse of q.
+func (q Quat) Inverse() Quat {
+	d := q.Dot(q)
+	return Quat{-q[0] / d, -q[1] / d, -q[2] / d, q[3] / d}
+}
+
+// Dot returns the dot product of q and r as 4-element vectors.
+func (q Quat) Dot(r Quat) float64 {
+	return q[0]*r[0] + q[1]*r[1] + q[2]*r[2] + q[3]*r[3]
+}
+
+// Len returns the norm of q.
+func (q Quat) Len() float64 {
+	return math.Sqrt(q.Dot(q))
+}
+
+// Normalize returns q scaled to unit length.
+func (q Quat) Normalize() Quat {
+	l := q.Len()
+	if l == 0 {
+		return QuatIdentity
+	}
+	return Quat{q[0] / l, q[1] / l, q[2] / l, q[3] / l}
+}
+
+// AxisAngle returns the axis and the angle in degrees of the rotation q, with an angle between 0 and 360.
+// For the identity the axis is the x axis.
+func (q Quat) AxisAngle() (Vec3, float64) {
+	q = q.Normalize()
+	s := math.Sqrt(1 - q[3]*q[3])
+	if s < 1e-12 {
+		return Vec3{1, 0, 0}, 0
+	}
+	return Vec3{q[0] / s, q[1] / s, q[2] / s}, 2 * math.Acos(math.Max(-1, math.Min(1, q[3]))) / deg
+}
+
+// Rotate applies the rotation q to v.
+func (q Quat) Rotate(v Vec3) Vec3 {
+	u := Vec3{q[0], q[1], q[2]}
+	t := u.Cross(v).Mul(2)
+	return v.Add(t.Mul(q[3])).Add(u.Cross(t))
+}
+
+// ToMat4 returns the rotation matrix of the unit quaternion q.
+func (q Quat) ToMat4() Mat4 {
+	x, y, z, w := q[0], q[1], q[2], q[3]
+	return Mat4{[4]float64{1 - 2*(y*y+z*z), 2 * (x*y - z*w), 2 * (x*z + y*w), 0},
+		[4]float64{2 * (x*y + z*w), 1 - 2*(x*x+z*z), 2 * (y*z - x*w), 0},
+		[4]float64{2 * (x*z - y*w), 2 * (y*z + x*w), 1 - 2*(x*x+y*y), 0},
+		[4]float64{0, 0, 0, 1}}
+}
+
+// Nlerp interpolates between q at t = 0 and r at t = 1 by normalizing the linear interpolation along the shorter arc.
+// It is cheaper than Slerp but does not rotate at constant speed.
+func (q Quat) Nlerp(r Quat, t float64) Quat {
+	if q.Dot(r) < 0 {
+		r = Quat{-r[0], -r[1], -r[2], -r[3]}
+	}
+	return Quat{
+		q[0] + (r[0]-q[0])*t,
+		q[1] + (r[1]-q[1])*t,
+		q[2] + (r[2]-q[2])*t,
+		q[3] + (r[3]-q[3])*t,
+	}.Normalize()
+}
+
+// Slerp interpolates spherically between the unit quaternions q at t = 0 and r at t = 1 along the shorter arc, i.e. at constant angular speed.
+func (q Quat) Slerp(r Quat, t float64) Quat {
+	d := q.Dot(r)
+	if d < 0 {
+		r, d = Quat{-r[0], -r[1], -r[2], -r[3]}, -d
+	}
+	if d > 0.9995 {
+		// nearly parallel; avoid dividing by a tiny sine
+		return q.Nlerp(r, t)
+	}
+	th := math.Acos(d)
+	a := math.Sin((1-t)*th) / math.Sin(th)
+	b := math.Sin(t*th) / math.Sin(th)
+	return Quat{a*q[0] + b*r[0], a*q[1] + b*r[1], a*q[2] + b*r[2], a*q[3] + b*r[3]}
+}
@@ -0,0 +1,120 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+import "errors"
+import "fmt"
+import "image"
+import "time"
+
+// ErrWaitFailed is returned by the methods of Readback if glClientWaitSync fails, e.g. because the context was lost.
+var ErrWaitFailed = errors.New("gl: glClientWaitSync failed")
+
+// The type Readback represents an asynchronous read of pixels into a pixel buffer object.
+// The read is performed by the GL in the background and a fence is used to find out when the result is available, so that the caller does not have to wait for the GL to finish rendering.
+type Readback struct {
+	buf  *Buffer
+	sync C.GLsync
+	w, h int
+}
+
+// ReadPixelsAsync starts reading the w by h rectangle with bottom left corner x, y from the current read buffer and returns without waiting for the result.
+func ReadPixelsAsync(x int, y int, w int, h int) *Readback {
+	r := &Readback{buf: NewBuffer(0, nil, 0)}
+	r.Read(x, y, w, h)
+	return r
+}
+
+// Read starts a new read into r, reusing its buffer object. A previous result that has not been retrieved is discarded.
+func (r *Readback) Read(x int, y int, w int, h int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Readback.Read", x, y, w, h))
+	}
+	r.deleteSync()
+	if r.buf.s < uintptr(4*w*h) {
+		r.buf.Alloc(PIXEL_PACK_BUFFER, 4*w*h, STREAM_READ)
+	}
+	r.buf.Bind(PIXEL_PACK_BUFFER)
+	C.glPixelStorei(PACK_ALIGNMENT, 1)
+	C.glReadPixels(C.GLint(x), C.GLint(y), C.GLsizei(w), C.GLsizei(h), RGBA, UNSIGNED_BYTE, nil)
+	r.buf.Unbind(PIXEL_PACK_BUFFER)
+	r.sync = C.glFenceSync(SYNC_GPU_COMMANDS_COMPLETE, 0)
+	r.w, r.h = w, h
+}
+
+// Ready reports whether Image will return without blocking, i.e. whether the result is available or waiting for it failed.
+func (r *Readback) Ready() bool {
+	ok, err := r.Wait(0)
+	return ok || err != nil
+}
+
+// Wait waits until the result is available or the timeout has elapsed and reports whether the result is available.
+// It returns ErrWaitFailed if the wait fails.
+func (r *Readback) Wait(timeout time.Duration) (bool, error) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Readback.Wait", timeout))
+	}
+	if r.sync == nil {
+		return true, nil
+	}
+	switch C.glClientWaitSync(r.sync, SYNC_FLUSH_COMMANDS_BIT, C.GLuint64(timeout)) {
+	case ALREADY_SIGNALED, CONDITION_SATISFIED:
+		r.deleteSync()
+		return true, nil
+	case WAIT_FAILED:
+		return false, ErrWaitFailed
+	}
+	return false, nil
+}
+
+// Image waits for the result and returns it as an image with the same orientation as ReadPixels.
+func (r *Readback) Image() (*image.RGBA, error) {
+	m := image.NewRGBA(image.Rect(0, 0, r.w, r.h))
+	if err := r.ImageInto(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ImageInto is like Image, but stores the result in dst. It returns an error if dst is smaller than the rectangle read.
+func (r *Readback) ImageInto(dst *image.RGBA) error {
+	if dst.Rect.Dx() < r.w || dst.Rect.Dy() < r.h {
+		return fmt.Errorf("gl: readback of %dx%d pixels does not fit into %v", r.w, r.h, dst.Rect)
+	}
+	for {
+		ok, err := r.Wait(time.Second)
+		if err != nil {
+			return err
+		}
+		if ok {
+			break
+		}
+	}
+	if r.w <= 0 || r.h <= 0 {
+		return nil
+	}
+	data := r.buf.Map(PIXEL_PACK_BUFFER, MAP_READ_BIT)
+	if data == nil {
+		r.buf.Unbind(PIXEL_PACK_BUFFER)
+		return errors.New("gl: glMapBufferRange failed")
+	}
+	n := 4 * r.w
+	for i := 0; i < r.h; i++ {
+		copy(dst.Pix[dst.PixOffset(dst.Rect.Min.X, dst.Rect.Min.Y+r.h-1-i):], data[i*n:(i+1)*n])
+	}
+	r.buf.Unmap(PIXEL_PACK_BUFFER)
+	return nil
+}
+
+// Delete deletes the buffer object and fence used by the readback.
+func (r *Readback) Delete() {
+	r.deleteSync()
+	DeleteBuffers(r.buf)
+}
+
+func (r *Readback) deleteSync() {
+	if r.sync != nil {
+		C.glDeleteSync(r.sync)
+		r.sync = nil
+	}
+}
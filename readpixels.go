@@ -0,0 +1,90 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+import "image"
+import "image/color"
+import "image/draw"
+import "unsafe"
+
+// flipRows reverses the order of h rows of n bytes each, stored stride bytes apart starting at off in pix.
+func flipRows(pix []byte, off int, stride int, n int, h int) {
+	tmp := make([]byte, n)
+	for i, j := off, off+(h-1)*stride; i < j; i, j = i+stride, j-stride {
+		copy(tmp, pix[i:i+n])
+		copy(pix[i:i+n], pix[j:j+n])
+		copy(pix[j:j+n], tmp)
+	}
+}
+
+// ReadBuffer calls glReadBuffer to select the color buffer of the current read framebuffer that ReadPixels and its variants read from.
+func ReadBuffer(mode int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("ReadBuffer", mode))
+	}
+	C.glReadBuffer(C.GLenum(mode))
+}
+
+// ReadPixelsRGBA is like ReadPixels, but returns an image with 8 bits per channel.
+func ReadPixelsRGBA(x int, y int, w int, h int) *image.RGBA {
+	rgba := image.NewRGBA(image.Rect(0, 0, w, h))
+	ReadPixelsInto(rgba, x, y)
+	return rgba
+}
+
+// ReadPixelsDepth calls glReadPixels to read the depth values of the w by h rectangle with bottom left corner x, y.
+// The result is indexed by row and column, with the rows ordered from top to bottom like in ReadPixels.
+func ReadPixelsDepth(x int, y int, w int, h int) [][]float32 {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("ReadPixelsDepth", x, y, w, h))
+	}
+	d := make([][]float32, h)
+	if w <= 0 || h <= 0 {
+		return d
+	}
+	data := make([]float32, w*h)
+	C.glPixelStorei(PACK_ALIGNMENT, 1)
+	C.glReadPixels(C.GLint(x), C.GLint(y), C.GLsizei(w), C.GLsizei(h), DEPTH_COMPONENT, FLOAT, unsafe.Pointer(&data[0]))
+	for i := range d {
+		d[h-1-i] = data[i*w : (i+1)*w]
+	}
+	return d
+}
+
+// ReadPixelsInto reads a rectangle with bottom left corner x, y and the size of dst's bounds into dst, with the same orientation as ReadPixels.
+// For *image.RGBA and *image.NRGBA the pixels are read directly into the existing Pix slice, so that no memory is allocated.
+func ReadPixelsInto(dst draw.Image, x int, y int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("ReadPixelsInto", dst, x, y))
+	}
+	r := dst.Bounds()
+	w, h := r.Dx(), r.Dy()
+	if w <= 0 || h <= 0 {
+		return
+	}
+	var pix []byte
+	var stride int
+	switch m := dst.(type) {
+	case *image.RGBA:
+		pix, stride = m.Pix[m.PixOffset(r.Min.X, r.Min.Y):], m.Stride
+	case *image.NRGBA:
+		pix, stride = m.Pix[m.PixOffset(r.Min.X, r.Min.Y):], m.Stride
+	default:
+		pix, stride = make([]byte, 4*w*h), 4*w
+	}
+	C.glPixelStorei(PACK_ALIGNMENT, 1)
+	C.glPixelStorei(PACK_ROW_LENGTH, C.GLint(stride/4))
+	C.glReadPixels(C.GLint(x), C.GLint(y), C.GLsizei(w), C.GLsizei(h), RGBA, UNSIGNED_BYTE, unsafe.Pointer(&pix[0]))
+	C.glPixelStorei(PACK_ROW_LENGTH, 0)
+	flipRows(pix, 0, stride, 4*w, h)
+	switch dst.(type) {
+	case *image.RGBA, *image.NRGBA:
+		return
+	}
+	for i := 0; i < h; i++ {
+		for j := 0; j < w; j++ {
+			p := pix[i*stride+4*j:]
+			dst.Set(r.Min.X+j, r.Min.Y+i, color.RGBA{p[0], p[1], p[2], p[3]})
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+
+// The type RenderState describes the fixed-function state used by draw calls: blending, depth and stencil tests, culling, scissoring, the color mask and the polygon offset.
+// It is a plain value; Apply makes the context match it while issuing only the calls needed to change the state set before.
+// A RenderState should be derived from DefaultRenderState, since the zero values of the enum fields are not valid.
+type RenderState struct {
+	Blend                                   bool
+	BlendSrcRGB, BlendDstRGB                BlendFactor
+	BlendSrcAlpha, BlendDstAlpha            BlendFactor
+	BlendOpRGB, BlendOpAlpha                BlendOp
+	BlendColor                              [4]float32
+	DepthTest                               bool
+	DepthFunc                               CompareFunc
+	DepthWrite                              bool
+	Cull                                    bool
+	CullFace                                Face
+	FrontFace                               Winding
+	StencilTest                             bool
+	StencilFront, StencilBack               StencilState
+	ScissorTest                             bool
+	Scissor                                 [4]int // x, y, w, h
+	ColorMask                               [4]bool
+	PolygonOffset                           bool // enables POLYGON_OFFSET_FILL
+	PolygonOffsetFactor, PolygonOffsetUnits float32
+}
+
+// The type StencilState is the stencil state of one polygon face.
+type StencilState struct {
+	Func                  CompareFunc
+	Ref                   int
+	Mask                  uint32 // the mask applied to the reference and the stored value
+	SFail, DPFail, DPPass StencilAction
+	WriteMask             uint32
+}
+
+// DefaultRenderState returns the initial state of a GL context, with the scissor box left empty.
+func DefaultRenderState() RenderState {
+	stencil := StencilState{
+		Func:      ALWAYS,
+		Mask:      ^uint32(0),
+		SFail:     KEEP,
+		DPFail:    KEEP,
+		DPPass:    KEEP,
+		WriteMask: ^uint32(0),
+	}
+	return RenderState{
+		BlendSrcRGB:   ONE,
+		BlendDstRGB:   ZERO,
+		BlendSrcAlpha: ONE,
+		BlendDstAlpha: ZERO,
+		BlendOpRGB:    FUNC_ADD,
+		BlendOpAlpha:  FUNC_ADD,
+		DepthFunc:     LESS,
+		DepthWrite:    true,
+		CullFace:      BACK,
+		FrontFace:     CCW,
+		StencilFront:  stencil,
+		StencilBack:   stencil,
+		ColorMask:     [4]bool{true, true, true, true},
+	}
+}
+
+// Apply sets the state described by s. The calls that would not change the state recorded since Init or the last InvalidateState are skipped.
+func (s RenderState) Apply() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("RenderState.Apply", s))
+	}
+	old, all := cache.render, !cache.renderValid
+	enable := func(c Capability, was, on bool) {
+		if all || was != on {
+			if on {
+				C.glEnable(C.GLenum(c))
+			} else {
+				C.glDisable(C.GLenum(c))
+			}
+		}
+	}
+	enable(BLEND, old.Blend, s.Blend)
+	if all || old.BlendSrcRGB != s.BlendSrcRGB || old.BlendDstRGB != s.BlendDstRGB || old.BlendSrcAlpha != s.BlendSrcAlpha || old.BlendDstAlpha != s.BlendDstAlpha {
+		C.glBlendFuncSeparate(C.GLenum(s.BlendSrcRGB), C.GLenum(s.BlendDstRGB), C.GLenum(s.BlendSrcAlpha), C.GLenum(s.BlendDstAlpha))
+	}
+	if all || old.BlendOpRGB != s.BlendOpRGB || old.BlendOpAlpha != s.BlendOpAlpha {
+		C.glBlendEquationSeparate(C.GLenum(s.BlendOpRGB), C.GLenum(s.BlendOpAlpha))
+	}
+	if all || old.BlendColor != s.BlendColor {
+		c := s.BlendColor
+		C.glBlendColor(C.GLfloat(c[0]), C.GLfloat(c[1]), C.GLfloat(c[2]), C.GLfloat(c[3]))
+	}
+	enable(DEPTH_TEST, old.DepthTest, s.DepthTest)
+	if all || old.DepthFunc != s.DepthFunc {
+		C.glDepthFunc(C.GLenum(s.DepthFunc))
+	}
+	if all || old.DepthWrite != s.DepthWrite {
+		C.glDepthMask(glBool(s.DepthWrite))
+	}
+	enable(CULL_FACE, old.Cull, s.Cull)
+	if all || old.CullFace != s.CullFace {
+		C.glCullFace(C.GLenum(s.CullFace))
+	}
+	if all || old.FrontFace != s.FrontFace {
+		C.glFrontFace(C.GLenum(s.FrontFace))
+	}
+	enable(STENCIL_TEST, old.StencilTest, s.StencilTest)
+	for _, f := range []struct {
+		face     Face
+		old, new StencilState
+	}{{FRONT, old.StencilFront, s.StencilFront}, {BACK, old.StencilBack, s.StencilBack}} {
+		o, n := f.old, f.new
+		if all || o.Func != n.Func || o.Ref != n.Ref || o.Mask != n.Mask {
+			C.glStencilFuncSeparate(C.GLenum(f.face), C.GLenum(n.Func), C.GLint(n.Ref), C.GLuint(n.Mask))
+		}
+		if all || o.SFail != n.SFail || o.DPFail != n.DPFail || o.DPPass != n.DPPass {
+			C.glStencilOpSeparate(C.GLenum(f.face), C.GLenum(n.SFail), C.GLenum(n.DPFail), C.GLenum(n.DPPass))
+		}
+		if all || o.WriteMask != n.WriteMask {
+			C.glStencilMaskSeparate(C.GLenum(f.face), C.GLuint(n.WriteMask))
+		}
+	}
+	enable(SCISSOR_TEST, old.ScissorTest, s.ScissorTest)
+	if all || old.Scissor != s.Scissor {
+		r := s.Scissor
+		C.glScissor(C.GLint(r[0]), C.GLint(r[1]), C.GLsizei(r[2]), C.GLsizei(r[3]))
+	}
+	if all || old.ColorMask != s.ColorMask {
+		m := s.ColorMask
+		C.glColorMask(glBool(m[0]), glBool(m[1]), glBool(m[2]), glBool(m[3]))
+	}
+	enable(POLYGON_OFFSET_FILL, old.PolygonOffset, s.PolygonOffset)
+	if all || old.PolygonOffsetFactor != s.PolygonOffsetFactor || old.PolygonOffsetUnits != s.PolygonOffsetUnits {
+		C.glPolygonOffset(C.GLfloat(s.PolygonOffsetFactor), C.GLfloat(s.PolygonOffsetUnits))
+	}
+	cache.render = s
+	cache.renderValid = true
+}
+
+func glBool(b bool) C.GLboolean {
+	if b {
+		return TRUE
+	}
+	return FALSE
+}
+
+// textureSlot is a binding point of a texture image unit.
+type textureSlot struct {
+	unit int
+	targ TextureTarget
+}
+
+// The type stateCache records the state set through the package, so that redundant calls can be skipped.
+// A binding missing from the maps is unknown and is always set.
+type stateCache struct {
+	render       RenderState
+	renderValid  bool
+	program      C.GLuint
+	programKnown bool
+	buffers      map[BufferTarget]C.GLuint
+	unit         int
+	unitKnown    bool
+	textures     map[textureSlot]C.GLuint
+	framebuffers map[FramebufferTarget]C.GLuint // by DRAW_FRAMEBUFFER and READ_FRAMEBUFFER
+}
+
+var cache = newStateCache()
+
+func newStateCache() stateCache {
+	return stateCache{
+		buffers:      make(map[BufferTarget]C.GLuint),
+		textures:     make(map[textureSlot]C.GLuint),
+		framebuffers: make(map[FramebufferTarget]C.GLuint),
+	}
+}
+
+// InvalidateState forgets the state recorded by the package, so that the next RenderState.Apply sets all state and the next binds are not skipped.
+// It has to be called after the GL state was changed without going through the package, e.g. by another library or after making a different context current.
+// Init calls it.
+func InvalidateState() {
+	cache = newStateCache()
+}
+
+// enable records a change of a capability made outside of RenderState.Apply.
+func (c *stateCache) enable(cp Capability, on bool) {
+	switch cp {
+	case BLEND:
+		c.render.Blend = on
+	case DEPTH_TEST:
+		c.render.DepthTest = on
+	case CULL_FACE:
+		c.render.Cull = on
+	case STENCIL_TEST:
+		c.render.StencilTest = on
+	case SCISSOR_TEST:
+		c.render.ScissorTest = on
+	case POLYGON_OFFSET_FILL:
+		c.render.PolygonOffset = on
+	}
+}
+
+// stencil returns the recorded stencil states of face.
+func (c *stateCache) stencil(face Face) []*StencilState {
+	switch face {
+	case FRONT:
+		return []*StencilState{&c.render.StencilFront}
+	case BACK:
+		return []*StencilState{&c.render.StencilBack}
+	}
+	return []*StencilState{&c.render.StencilFront, &c.render.StencilBack}
+}
+
+func bindBuffer(targ BufferTarget, i C.GLuint) {
+	if b, ok := cache.buffers[targ]; ok && b == i {
+		return
+	}
+	C.glBindBuffer(C.GLenum(targ), i)
+	cache.buffers[targ] = i
+}
+
+// forgetBuffer removes a deleted buffer from the recorded bindings, since deleting unbinds it.
+func forgetBuffer(i C.GLuint) {
+	for t, b := range cache.buffers {
+		if b == i {
+			delete(cache.buffers, t)
+		}
+	}
+}
+
+func useProgram(i C.GLuint) {
+	if cache.programKnown && cache.program == i {
+		return
+	}
+	C.glUseProgram(i)
+	cache.program, cache.programKnown = i, true
+}
+
+func activeTexture(unit int) {
+	if cache.unitKnown && cache.unit == unit {
+		return
+	}
+	C.glActiveTexture(TEXTURE0 + C.GLenum(unit))
+	cache.unit, cache.unitKnown = unit, true
+}
+
+func bindTexture(targ TextureTarget, i C.GLuint) {
+	if !cache.unitKnown {
+		C.glBindTexture(C.GLenum(targ), i)
+		return
+	}
+	slot := textureSlot{cache.unit, targ}
+	if t, ok := cache.textures[slot]; ok && t == i {
+		return
+	}
+	C.glBindTexture(C.GLenum(targ), i)
+	cache.textures[slot] = i
+}
+
+// forgetTexture removes a deleted texture from the recorded bindings, since deleting unbinds it from all units.
+func forgetTexture(i C.GLuint) {
+	for s, t := range cache.textures {
+		if t == i {
+			delete(cache.textures, s)
+		}
+	}
+}
+
+// bindFramebuffer binds i to targ unless it is bound already. FRAMEBUFFER stands for both DRAW_FRAMEBUFFER and READ_FRAMEBUFFER.
+func bindFramebuffer(targ FramebufferTarget, i C.GLuint) {
+	if targ == FRAMEBUFFER {
+		d, dok := cache.framebuffers[DRAW_FRAMEBUFFER]
+		r, rok := cache.framebuffers[READ_FRAMEBUFFER]
+		if dok && rok && d == i && r == i {
+			return
+		}
+		C.glBindFramebuffer(C.GLenum(targ), i)
+		cache.framebuffers[DRAW_FRAMEBUFFER] = i
+		cache.framebuffers[READ_FRAMEBUFFER] = i
+		return
+	}
+	if f, ok := cache.framebuffers[targ]; ok && f == i {
+		return
+	}
+	C.glBindFramebuffer(C.GLenum(targ), i)
+	cache.framebuffers[targ] = i
+}
+
+// boundFramebuffer returns the framebuffer bound to targ, DRAW_FRAMEBUFFER or READ_FRAMEBUFFER, and queries it if it is not recorded.
+func boundFramebuffer(targ FramebufferTarget) C.GLuint {
+	if f, ok := cache.framebuffers[targ]; ok {
+		return f
+	}
+	name := C.GLenum(DRAW_FRAMEBUFFER_BINDING)
+	if targ == READ_FRAMEBUFFER {
+		name = READ_FRAMEBUFFER_BINDING
+	}
+	f := C.GLuint(getInteger(name))
+	cache.framebuffers[targ] = f
+	return f
+}
+
+// forgetFramebuffer records that deleting a framebuffer bound the default framebuffer in its place.
+func forgetFramebuffer(i C.GLuint) {
+	for t, f := range cache.framebuffers {
+		if f == i {
+			cache.framebuffers[t] = 0
+		}
+	}
+}
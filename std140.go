@@ -0,0 +1,134 @@
+package gl
+
+import "math"
+import "reflect"
+import "unsafe"
+
+// EncodeStd140 encodes v, which must be a struct or a pointer to one, according to the std140 layout rules for uniform blocks.
+// The result can be loaded into a Buffer bound to UNIFORM_BUFFER.
+// Go types are mapped to GLSL types as follows:
+// float32 and float64 to float, signed integers to int, unsigned integers to uint and bool to bool;
+// arrays of length 2 to 4 of these, including Vec2, Vec3, Vec4 and Quat, to the corresponding vector types;
+// two-dimensional float arrays with 2 to 4 rows and columns, including Mat2, Mat3, Mat4 and the non-square types such as Mat2x3, to matrices, using the same orientation as Program.SetUniform, e.g. [3][2]float32 to mat2x3;
+// any other arrays and slices to arrays and structs to structs.
+// Fields tagged with `std140:"-"` are skipped and fields tagged with `std140:"array"` are encoded as arrays even if they would map to a vector or matrix, e.g. [3]float32 to float[3] and [3][2]float32 to vec2[3].
+// NB: As for SetUniform, float64 values are converted to single precision.
+func EncodeStd140(v interface{}) []byte {
+	val := reflect.Indirect(reflect.ValueOf(v))
+	if val.Kind() != reflect.Struct {
+		panic("EncodeStd140: not a struct")
+	}
+	var e std140
+	e.value(val)
+	return e.b
+}
+
+type std140 struct {
+	b []byte
+}
+
+func (e *std140) align(n int) {
+	for len(e.b)%n != 0 {
+		e.b = append(e.b, 0)
+	}
+}
+
+func (e *std140) put(u uint32) {
+	var b [4]byte
+	*(*uint32)(unsafe.Pointer(&b[0])) = u
+	e.b = append(e.b, b[:]...)
+}
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+func isVector(t reflect.Type) bool {
+	return t.Kind() == reflect.Array && t.Len() >= 2 && t.Len() <= 4 && isScalar(t.Elem())
+}
+
+func isMatrix(t reflect.Type) bool {
+	if t.Kind() != reflect.Array || t.Len() < 2 || t.Len() > 4 {
+		return false
+	}
+	c := t.Elem()
+	if c.Kind() != reflect.Array || c.Len() < 2 || c.Len() > 4 {
+		return false
+	}
+	k := c.Elem().Kind()
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+func (e *std140) scalar(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			e.put(1)
+		} else {
+			e.put(0)
+		}
+	case reflect.Float32, reflect.Float64:
+		e.put(math.Float32bits(float32(v.Float())))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.put(uint32(int32(v.Int())))
+	default:
+		e.put(uint32(v.Uint()))
+	}
+}
+
+func (e *std140) array(v reflect.Value) {
+	for i := 0; i < v.Len(); i++ {
+		e.align(16)
+		e.value(v.Index(i))
+	}
+	e.align(16)
+}
+
+func (e *std140) value(v reflect.Value) {
+	t := v.Type()
+	switch {
+	case isScalar(t):
+		e.align(4)
+		e.scalar(v)
+	case isVector(t):
+		if t.Len() == 2 {
+			e.align(8)
+		} else {
+			e.align(16)
+		}
+		for i := 0; i < v.Len(); i++ {
+			e.scalar(v.Index(i))
+		}
+	case isMatrix(t):
+		// stored as an array of column vectors
+		for c := 0; c < v.Index(0).Len(); c++ {
+			e.align(16)
+			for r := 0; r < v.Len(); r++ {
+				e.scalar(v.Index(r).Index(c))
+			}
+		}
+		e.align(16)
+	case t.Kind() == reflect.Array || t.Kind() == reflect.Slice:
+		e.array(v)
+	case t.Kind() == reflect.Struct:
+		e.align(16)
+		for i := 0; i < v.NumField(); i++ {
+			switch t.Field(i).Tag.Get("std140") {
+			case "-":
+			case "array":
+				e.array(v.Field(i))
+			default:
+				e.value(v.Field(i))
+			}
+		}
+		e.align(16)
+	default:
+		panic("EncodeStd140: unsupported type " + t.String())
+	}
+}
@@ -0,0 +1,121 @@
+package gl
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// floatsAt returns the float32 values at the given byte offsets of b.
+func floatsAt(b []byte, offsets ...int) []float32 {
+	var f []float32
+	for _, o := range offsets {
+		f = append(f, math.Float32frombits(binary.LittleEndian.Uint32(b[o:])))
+	}
+	return f
+}
+
+func TestStd140NonSquare(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		v    interface{}
+		size int
+	}{
+		{"Mat2x3", struct{ M Mat2x3 }{}, 32},
+		{"Mat3x2", struct{ M Mat3x2 }{}, 48},
+		{"[3][2]float32", struct{ M [3][2]float32 }{}, 32},
+		{"[2][3]float32", struct{ M [2][3]float32 }{}, 48},
+		{"[4][2]float64", struct{ M [4][2]float64 }{}, 32},
+		{"vec2[3]", struct {
+			M [3][2]float32 `std140:"array"`
+		}{}, 48},
+	} {
+		if n := len(EncodeStd140(c.v)); n != c.size {
+			t.Errorf("%s: %d bytes, want %d", c.name, n, c.size)
+		}
+	}
+	// the columns of a mat2x3 are the columns of the 3x2 array, each padded to a vec4
+	b := EncodeStd140(struct{ M Mat2x3 }{Mat2x3{{1, 2}, {3, 4}, {5, 6}}})
+	want := []float32{1, 3, 5, 2, 4, 6}
+	got := floatsAt(b, 0, 4, 8, 16, 20, 24)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Mat2x3 encoded as %v, want %v", got, want)
+		}
+	}
+}
+
+// std140Layout mirrors the uniform block
+//
+//	struct S { float x; vec2 y; };
+//	layout(std140) uniform B { float a; vec3 b; float c; vec2 d; bool e; float f[3]; S g; mat3 h; int i; int k[2]; mat2 l[2]; };
+//
+// whose offsets and size were taken from the driver.
+type std140Layout struct {
+	A float32
+	B Vec3
+	C float32
+	D [2]float32
+	E bool
+	F [3]float32 `std140:"array"`
+	G struct {
+		X float32
+		Y [2]float32
+	}
+	H Mat3
+	I int32
+	J uint8 `std140:"-"`
+	K []int32
+	L [2]Mat2
+}
+
+func TestStd140Layout(t *testing.T) {
+	var v std140Layout
+	v.A, v.B, v.C, v.D, v.E = 1, Vec3{2, 3, 4}, 5, [2]float32{6, 7}, true
+	v.F = [3]float32{8, 9, 10}
+	v.G.X, v.G.Y = 11, [2]float32{12, 13}
+	v.H = Mat3{{14, 15, 16}, {17, 18, 19}, {20, 21, 22}}
+	v.I, v.J, v.K = -23, 99, []int32{24, 25}
+	v.L = [2]Mat2{{{26, 27}, {28, 29}}, {{30, 31}, {32, 33}}}
+	b := EncodeStd140(&v)
+	if len(b) != 272 {
+		t.Fatalf("encoded %d bytes, want 272", len(b))
+	}
+	for _, c := range []struct {
+		name   string
+		offset int
+		want   float32
+	}{
+		{"a", 0, 1},
+		{"b.x", 16, 2}, {"b.z", 24, 4},
+		{"c", 28, 5},
+		{"d.x", 32, 6}, {"d.y", 36, 7},
+		{"f[0]", 48, 8}, {"f[1]", 64, 9}, {"f[2]", 80, 10},
+		{"g.x", 96, 11}, {"g.y.x", 104, 12}, {"g.y.y", 108, 13},
+		// the columns of h are the columns of the array, each in a vec4
+		{"h[0][0]", 112, 14}, {"h[0][1]", 116, 17}, {"h[0][2]", 120, 20}, {"h[1][0]", 128, 15}, {"h[2][2]", 152, 22},
+		{"l[0][1][0]", 224, 27}, {"l[1][0][0]", 240, 30}, {"l[1][1][1]", 260, 33},
+	} {
+		if f := floatsAt(b, c.offset)[0]; f != c.want {
+			t.Errorf("%s at %d is %g, want %g", c.name, c.offset, f, c.want)
+		}
+	}
+	for _, c := range []struct {
+		name   string
+		offset int
+		want   int32
+	}{
+		{"e", 40, 1},
+		{"i", 160, -23},
+		{"k[0]", 176, 24}, {"k[1]", 192, 25},
+	} {
+		if i := int32(binary.LittleEndian.Uint32(b[c.offset:])); i != c.want {
+			t.Errorf("%s at %d is %d, want %d", c.name, c.offset, i, c.want)
+		}
+	}
+	for _, o := range []int{12, 44, 52, 100, 124, 164, 180, 232} {
+		if binary.LittleEndian.Uint32(b[o:]) != 0 {
+			t.Errorf("padding at %d is not zero", o)
+		}
+	}
+}
@@ -11,20 +11,44 @@ import (
 )
 
 var Vertices = []float64{
+	-1, 1, -1, 0, 1,
+	1, 1, -1, 1, 1,
+	-1, -1, -1, 0, 0,
+	1, -1, -1, 1, 0,
+
+	1, 1, 1, 0, 1,
+	-1, 1, 1, 1, 1,
+	1, -1, 1, 0, 0,
+	-1, -1, 1, 1, 0,
+
+	-1, 1, 1, 0, 1,
+	-1, 1, -1, 1, 1,
+	-1, -1, 1, 0, 0,
+	-1, -1, -1, 1, 0,
+
+	1, 1, -1, 0, 1,
+	1, 1, 1, 1, 1,
+	1, -1, -1, 0, 0,
+	1, -1, 1, 1, 0,
+
+	-1, 1, 1, 0, 1,
+	1, 1, 1, 1, 1,
 	-1, 1, -1, 0, 0,
 	1, 1, -1, 1, 0,
+
 	-1, -1, -1, 0, 1,
 	1, -1, -1, 1, 1,
-	1, -1, 1, 1, 0,
-	1, 1, -1, 0, 1,
-	1, 1, 1, 0, 0,
-	-1, 1, -1, 1, 1,
-	-1, 1, 1, 1, 0,
-	-1, -1, -1, 0, 1,
 	-1, -1, 1, 0, 0,
 	1, -1, 1, 1, 0,
-	-1, 1, 1, 0, 1,
-	1, 1, 1, 1, 1,
+}
+
+var Indices = []uint16{
+	0, 1, 2, 2, 1, 3,
+	4, 5, 6, 6, 5, 7,
+	8, 9, 10, 10, 9, 11,
+	12, 13, 14, 14, 13, 15,
+	16, 17, 18, 18, 17, 19,
+	20, 21, 22, 22, 21, 23,
 }
 
 var vertexShader = `
@@ -55,17 +79,25 @@ void main() {
 func main() {
 	sdl.Init(sdl.INIT_VIDEO)
 	sdl.SetVideoMode(800, 600, 32, sdl.OPENGL|sdl.DOUBLEBUF|sdl.HWSURFACE)
-	gl.Init()
+	if err := gl.Init(); err != nil {
+		fmt.Println(err)
+		return
+	}
 	gl.Enable(gl.DEPTH_TEST)
 	gl.Viewport(0, 0, 800, 600)
 	tick := time.Tick(time.Second / 50)
 	timer := 0.0
 	posbuf := gl.NewBuffer(gl.ARRAY_BUFFER, Vertices, gl.STATIC_DRAW)
+	idxbuf := gl.NewBuffer(gl.ELEMENT_ARRAY_BUFFER, Indices, gl.STATIC_DRAW)
 	prog, err := gl.MakeProgram([]string{vertexShader}, []string{fragmentShader})
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
+	vao := gl.NewVertexArray()
+	vao.EnableAttrib(prog, "position", posbuf, 0, 3, 5, false)
+	vao.EnableAttrib(prog, "texcoord", posbuf, 3, 2, 5, false)
+	vao.SetElements(idxbuf)
 	f, err := os.Open("glenda.png")
 	if err != nil {
 		fmt.Println(err)
@@ -88,14 +120,13 @@ func main() {
 			gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
 
 			prog.Use()
-			mat := gl.Mul4(gl.Frustum(45, 800./600, 0.01, 100), gl.Translate(0, 0, -8), gl.RotX(timer), gl.RotY(2*timer), gl.RotZ(3*timer))
-			prog.EnableAttrib("position", posbuf, 0, 3, 5, false)
-			prog.EnableAttrib("texcoord", posbuf, 3, 2, 5, false)
+			mat := gl.Mul4(gl.Perspective(45, 800./600, 0.01, 100), gl.Translate(0, 0, -8), gl.RotX(timer), gl.RotY(2*timer), gl.RotZ(3*timer))
 			prog.SetUniform("tex", 0)
 			prog.SetUniform("matrix", mat)
 			tex.Enable(0, gl.TEXTURE_2D)
-			gl.DrawArrays(gl.TRIANGLE_STRIP, 0, len(Vertices)/5)
-			prog.DisableAttrib("position")
+			vao.Bind()
+			gl.DrawElements(gl.TRIANGLES, vao.Elements(), 0, len(Indices))
+			vao.Unbind()
 			prog.Unuse()
 
 			sdl.GL_SwapBuffers()
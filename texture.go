@@ -0,0 +1,357 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+import "fmt"
+import "image"
+import "image/color"
+import "image/draw"
+import "unsafe"
+
+// texFormat describes the pixel format and type used to transfer data to a texture with a given internal format.
+// If packed is set, typ is used even when data is given, since the packed layout cannot be derived from the Go type.
+type texFormat struct {
+	format, typ int
+	packed      bool
+}
+
+// texFormats holds the base internal formats and the sized internal formats of tables 8.12 and 8.13 of the OpenGL 4.3 core specification.
+var texFormats = map[int]texFormat{
+	RED:                {RED, UNSIGNED_BYTE, false},
+	RG:                 {RG, UNSIGNED_BYTE, false},
+	RGB:                {RGB, UNSIGNED_BYTE, false},
+	RGBA:               {RGBA, UNSIGNED_BYTE, false},
+	R8:                 {RED, UNSIGNED_BYTE, false},
+	R8_SNORM:           {RED, BYTE, false},
+	R16:                {RED, UNSIGNED_SHORT, false},
+	R16_SNORM:          {RED, SHORT, false},
+	RG8:                {RG, UNSIGNED_BYTE, false},
+	RG8_SNORM:          {RG, BYTE, false},
+	RG16:               {RG, UNSIGNED_SHORT, false},
+	RG16_SNORM:         {RG, SHORT, false},
+	R3_G3_B2:           {RGB, UNSIGNED_BYTE, false},
+	RGB4:               {RGB, UNSIGNED_BYTE, false},
+	RGB5:               {RGB, UNSIGNED_BYTE, false},
+	RGB565:             {RGB, UNSIGNED_BYTE, false},
+	RGB8:               {RGB, UNSIGNED_BYTE, false},
+	RGB8_SNORM:         {RGB, BYTE, false},
+	RGB10:              {RGB, UNSIGNED_SHORT, false},
+	RGB12:              {RGB, UNSIGNED_SHORT, false},
+	RGB16:              {RGB, UNSIGNED_SHORT, false},
+	RGB16_SNORM:        {RGB, SHORT, false},
+	RGBA2:              {RGBA, UNSIGNED_BYTE, false},
+	RGBA4:              {RGBA, UNSIGNED_BYTE, false},
+	RGB5_A1:            {RGBA, UNSIGNED_BYTE, false},
+	RGBA8:              {RGBA, UNSIGNED_BYTE, false},
+	RGBA8_SNORM:        {RGBA, BYTE, false},
+	RGB10_A2:           {RGBA, UNSIGNED_BYTE, false},
+	RGB10_A2UI:         {RGBA_INTEGER, UNSIGNED_INT_2_10_10_10_REV, true},
+	RGBA12:             {RGBA, UNSIGNED_SHORT, false},
+	RGBA16:             {RGBA, UNSIGNED_SHORT, false},
+	RGBA16_SNORM:       {RGBA, SHORT, false},
+	SRGB8:              {RGB, UNSIGNED_BYTE, false},
+	SRGB8_ALPHA8:       {RGBA, UNSIGNED_BYTE, false},
+	R16F:               {RED, FLOAT, false},
+	RG16F:              {RG, FLOAT, false},
+	RGB16F:             {RGB, FLOAT, false},
+	RGBA16F:            {RGBA, FLOAT, false},
+	R32F:               {RED, FLOAT, false},
+	RG32F:              {RG, FLOAT, false},
+	RGB32F:             {RGB, FLOAT, false},
+	RGBA32F:            {RGBA, FLOAT, false},
+	R11F_G11F_B10F:     {RGB, FLOAT, false},
+	RGB9_E5:            {RGB, FLOAT, false},
+	R8I:                {RED_INTEGER, BYTE, false},
+	R8UI:               {RED_INTEGER, UNSIGNED_BYTE, false},
+	R16I:               {RED_INTEGER, SHORT, false},
+	R16UI:              {RED_INTEGER, UNSIGNED_SHORT, false},
+	R32I:               {RED_INTEGER, INT, false},
+	R32UI:              {RED_INTEGER, UNSIGNED_INT, false},
+	RG8I:               {RG_INTEGER, BYTE, false},
+	RG8UI:              {RG_INTEGER, UNSIGNED_BYTE, false},
+	RG16I:              {RG_INTEGER, SHORT, false},
+	RG16UI:             {RG_INTEGER, UNSIGNED_SHORT, false},
+	RG32I:              {RG_INTEGER, INT, false},
+	RG32UI:             {RG_INTEGER, UNSIGNED_INT, false},
+	RGB8I:              {RGB_INTEGER, BYTE, false},
+	RGB8UI:             {RGB_INTEGER, UNSIGNED_BYTE, false},
+	RGB16I:             {RGB_INTEGER, SHORT, false},
+	RGB16UI:            {RGB_INTEGER, UNSIGNED_SHORT, false},
+	RGB32I:             {RGB_INTEGER, INT, false},
+	RGB32UI:            {RGB_INTEGER, UNSIGNED_INT, false},
+	RGBA8I:             {RGBA_INTEGER, BYTE, false},
+	RGBA8UI:            {RGBA_INTEGER, UNSIGNED_BYTE, false},
+	RGBA16I:            {RGBA_INTEGER, SHORT, false},
+	RGBA16UI:           {RGBA_INTEGER, UNSIGNED_SHORT, false},
+	RGBA32I:            {RGBA_INTEGER, INT, false},
+	RGBA32UI:           {RGBA_INTEGER, UNSIGNED_INT, false},
+	DEPTH_COMPONENT:    {DEPTH_COMPONENT, FLOAT, false},
+	DEPTH_COMPONENT16:  {DEPTH_COMPONENT, FLOAT, false},
+	DEPTH_COMPONENT24:  {DEPTH_COMPONENT, FLOAT, false},
+	DEPTH_COMPONENT32:  {DEPTH_COMPONENT, FLOAT, false},
+	DEPTH_COMPONENT32F: {DEPTH_COMPONENT, FLOAT, false},
+	DEPTH_STENCIL:      {DEPTH_STENCIL, UNSIGNED_INT_24_8, true},
+	DEPTH24_STENCIL8:   {DEPTH_STENCIL, UNSIGNED_INT_24_8, true},
+	DEPTH32F_STENCIL8:  {DEPTH_STENCIL, FLOAT_32_UNSIGNED_INT_24_8_REV, true},
+}
+
+// pixelData returns the arguments to pass to glTexImage* to load data into a texture with the given internal format.
+// data may be nil, in which case only storage is allocated.
+// Since Go slices are tightly packed, it also sets GL_UNPACK_ALIGNMENT to 1.
+func pixelData(internalformat int, data interface{}) (p unsafe.Pointer, format C.GLenum, typ C.GLenum) {
+	f, ok := texFormats[internalformat]
+	if !ok {
+		panic(fmt.Sprintf("unknown internal format %#x", internalformat))
+	}
+	format, typ = C.GLenum(f.format), C.GLenum(f.typ)
+	if data != nil {
+		p, typ, _, _ = toCtype(data)
+		if f.packed {
+			typ = C.GLenum(f.typ)
+		}
+	}
+	C.glPixelStorei(UNPACK_ALIGNMENT, 1)
+	return
+}
+
+// bindTarget returns the target a texture has to be bound to in order to modify the image targ.
+func bindTarget(targ TextureTarget) TextureTarget {
+	if targ >= TEXTURE_CUBE_MAP_POSITIVE_X && targ <= TEXTURE_CUBE_MAP_NEGATIVE_Z {
+		return TEXTURE_CUBE_MAP
+	}
+	return targ
+}
+
+// rows returns h rows of n bytes each, stored stride bytes apart starting at off in pix, as a contiguous slice.
+// If flip is set, the rows are returned in reverse order.
+func rows(pix []byte, off int, stride int, n int, h int, flip bool) []byte {
+	if !flip && stride == n {
+		return pix[off : off+n*h]
+	}
+	data := make([]byte, n*h)
+	for y := 0; y < h; y++ {
+		d := y
+		if flip {
+			d = h - 1 - y
+		}
+		copy(data[d*n:(d+1)*n], pix[off+y*stride:])
+	}
+	return data
+}
+
+// imageData returns the pixels of img row by row together with the internal format and pixel format to load them with; the pixel type is always UNSIGNED_BYTE.
+// If flip is set, the rows are returned starting with the bottom row, so that the image appears upright with texture coordinate (0, 0) at its bottom left corner, consistent with ReadPixels.
+// *image.RGBA, *image.NRGBA and *image.Gray are copied directly from their Pix slices and *image.YCbCr, e.g. from image/jpeg, is converted directly to RGBA.
+// Other images, and *image.Gray if texture swizzling is not supported, are converted to *image.RGBA with draw.Draw first.
+func imageData(img image.Image, flip bool) (data []byte, internalformat int, format int) {
+	r := img.Bounds()
+	switch m := img.(type) {
+	case *image.RGBA:
+		return rows(m.Pix, m.PixOffset(r.Min.X, r.Min.Y), m.Stride, 4*r.Dx(), r.Dy(), flip), RGBA8, RGBA
+	case *image.NRGBA:
+		return rows(m.Pix, m.PixOffset(r.Min.X, r.Min.Y), m.Stride, 4*r.Dx(), r.Dy(), flip), RGBA8, RGBA
+	case *image.Gray:
+		// single channel textures need swizzling to sample like other images
+		if swizzleSupported() {
+			return rows(m.Pix, m.PixOffset(r.Min.X, r.Min.Y), m.Stride, r.Dx(), r.Dy(), flip), R8, RED
+		}
+	case *image.YCbCr:
+		n := 4 * r.Dx()
+		data := make([]byte, n*r.Dy())
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			d := y - r.Min.Y
+			if flip {
+				d = r.Max.Y - 1 - y
+			}
+			row := data[d*n : (d+1)*n]
+			for x := r.Min.X; x < r.Max.X; x++ {
+				yi, ci := m.YOffset(x, y), m.COffset(x, y)
+				i := 4 * (x - r.Min.X)
+				row[i], row[i+1], row[i+2] = color.YCbCrToRGB(m.Y[yi], m.Cb[ci], m.Cr[ci])
+				row[i+3] = 255
+			}
+		}
+		return data, RGBA8, RGBA
+	}
+	m := image.NewRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	draw.Draw(m, m.Bounds(), img, r.Min, draw.Src)
+	return imageData(m, flip)
+}
+
+// texImage loads img into level 0 of the image targ of the bound texture.
+// Gray images are stored with a single channel, which is swizzled to the green and blue channels so that they sample like other images, if texture swizzling is supported.
+func texImage(targ TextureTarget, img image.Image, border int, flip bool) {
+	data, internalformat, format := imageData(img, flip)
+	C.glPixelStorei(UNPACK_ALIGNMENT, 1)
+	C.glTexImage2D(C.GLenum(targ), 0, C.GLint(internalformat), C.GLsizei(img.Bounds().Dx()), C.GLsizei(img.Bounds().Dy()), C.GLint(border), C.GLenum(format), UNSIGNED_BYTE, bytePointer(data))
+	if internalformat == R8 {
+		b := C.GLenum(bindTarget(targ))
+		C.glTexParameteri(b, TEXTURE_SWIZZLE_G, RED)
+		C.glTexParameteri(b, TEXTURE_SWIZZLE_B, RED)
+	}
+}
+
+// swizzleSupported reports whether the context supports TEXTURE_SWIZZLE_*, which is core since OpenGL 3.3.
+func swizzleSupported() bool {
+	return caps.AtLeast(3, 3) || caps.HasExtension("GL_ARB_texture_swizzle")
+}
+
+func bytePointer(data []byte) unsafe.Pointer {
+	if len(data) == 0 {
+		return nil
+	}
+	return unsafe.Pointer(&data[0])
+}
+
+// newTexture creates a texture object, binds it to targ and sets GL_TEXTURE_{MIN,MAG}_FILTER to GL_NEAREST, so that it is complete without mipmaps.
+func newTexture(targ TextureTarget) Texture {
+	var t C.GLuint
+
+	C.glGenTextures(1, &t)
+	trackObject(TEXTURE, t)
+	tt := Texture(t)
+	tt.Bind(targ)
+	C.glTexParameteri(C.GLenum(targ), TEXTURE_MIN_FILTER, NEAREST)
+	C.glTexParameteri(C.GLenum(targ), TEXTURE_MAG_FILTER, NEAREST)
+	return tt
+}
+
+// NewTexture2DFormat creates a new two-dimensional texture of size w by h with the given internal format, e.g. R8, RG16F, RGBA32F, SRGB8_ALPHA8 or DEPTH24_STENCIL8.
+// All base and uncompressed sized internal formats of OpenGL 4.3 core are accepted; other formats cause a panic.
+// data is a slice holding the texels row by row, starting with the bottom row; it may be nil to allocate storage without initializing it, e.g. for a framebuffer attachment.
+// The pixel format is derived from the internal format and the pixel type from the type of data, like for Buffer.Set.
+// GL_TEXTURE_{MIN,MAG}_FILTER are set to GL_NEAREST.
+func NewTexture2DFormat(internalformat int, w int, h int, data interface{}) Texture {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewTexture2DFormat", internalformat, w, h, data))
+	}
+	t := newTexture(TEXTURE_2D)
+	p, format, typ := pixelData(internalformat, data)
+	C.glTexImage2D(C.GLenum(TEXTURE_2D), 0, C.GLint(internalformat), C.GLsizei(w), C.GLsizei(h), 0, format, typ, p)
+	t.Unbind(TEXTURE_2D)
+	return t
+}
+
+// NewTexture3D creates a new three-dimensional texture of size w by h by d. The other arguments are as for NewTexture2DFormat.
+func NewTexture3D(internalformat int, w int, h int, d int, data interface{}) Texture {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewTexture3D", internalformat, w, h, d, data))
+	}
+	t := newTexture(TEXTURE_3D)
+	p, format, typ := pixelData(internalformat, data)
+	C.glTexImage3D(C.GLenum(TEXTURE_3D), 0, C.GLint(internalformat), C.GLsizei(w), C.GLsizei(h), C.GLsizei(d), 0, format, typ, p)
+	t.Unbind(TEXTURE_3D)
+	return t
+}
+
+// NewTexture2DArray creates a new array of layers two-dimensional textures of size w by h. The other arguments are as for NewTexture2DFormat.
+func NewTexture2DArray(internalformat int, w int, h int, layers int, data interface{}) Texture {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewTexture2DArray", internalformat, w, h, layers, data))
+	}
+	t := newTexture(TEXTURE_2D_ARRAY)
+	p, format, typ := pixelData(internalformat, data)
+	C.glTexImage3D(C.GLenum(TEXTURE_2D_ARRAY), 0, C.GLint(internalformat), C.GLsizei(w), C.GLsizei(h), C.GLsizei(layers), 0, format, typ, p)
+	t.Unbind(TEXTURE_2D_ARRAY)
+	return t
+}
+
+// NewTextureCube creates a new cube map texture from six square images of equal size, given in the order +X, -X, +Y, -Y, +Z, -Z.
+// The formats are chosen as for NewTexture2D, but the images are not flipped, since the cube map conventions expect the top row first.
+// It sets GL_TEXTURE_{MIN,MAG}_FILTER to GL_NEAREST and GL_TEXTURE_WRAP_{S,T,R} to GL_CLAMP_TO_EDGE.
+func NewTextureCube(faces [6]image.Image) Texture {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewTextureCube", faces))
+	}
+	t := newTexture(TEXTURE_CUBE_MAP)
+	for i, img := range faces {
+		texImage(TEXTURE_CUBE_MAP_POSITIVE_X+TextureTarget(i), img, 0, false)
+	}
+	clampCube()
+	t.Unbind(TEXTURE_CUBE_MAP)
+	return t
+}
+
+// NewTextureCubeFormat creates a new cube map texture with faces of size by size texels and the given internal format.
+// The faces are given in the same order as for NewTextureCube and are as for NewTexture2DFormat; any of them may be nil.
+func NewTextureCubeFormat(internalformat int, size int, faces [6]interface{}) Texture {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewTextureCubeFormat", internalformat, size, faces))
+	}
+	t := newTexture(TEXTURE_CUBE_MAP)
+	for i, data := range faces {
+		p, format, typ := pixelData(internalformat, data)
+		C.glTexImage2D(C.GLenum(TEXTURE_CUBE_MAP_POSITIVE_X+TextureTarget(i)), 0, C.GLint(internalformat), C.GLsizei(size), C.GLsizei(size), 0, format, typ, p)
+	}
+	clampCube()
+	t.Unbind(TEXTURE_CUBE_MAP)
+	return t
+}
+
+func clampCube() {
+	C.glTexParameteri(C.GLenum(TEXTURE_CUBE_MAP), TEXTURE_WRAP_S, CLAMP_TO_EDGE)
+	C.glTexParameteri(C.GLenum(TEXTURE_CUBE_MAP), TEXTURE_WRAP_T, CLAMP_TO_EDGE)
+	C.glTexParameteri(C.GLenum(TEXTURE_CUBE_MAP), TEXTURE_WRAP_R, CLAMP_TO_EDGE)
+}
+
+// Delete calls glDeleteTextures
+func (t Texture) Delete() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.Delete"))
+	}
+	i := C.GLuint(t)
+	untrackObject(TEXTURE, i)
+	forgetTexture(i)
+	C.glDeleteTextures(1, &i)
+}
+
+// GenerateMipmap calls glGenerateMipmap. To use the mipmaps, GL_TEXTURE_MIN_FILTER has to be set to one of the mipmap filters with TexParameteri.
+func (t Texture) GenerateMipmap(targ TextureTarget) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.GenerateMipmap", targ))
+	}
+	t.Bind(targ)
+	C.glGenerateMipmap(C.GLenum(targ))
+	t.Unbind(targ)
+}
+
+// SubImage2D calls glTexSubImage2D to replace a w by h rectangle at x, y of the given mipmap level. targ is TEXTURE_2D or one of the cube map faces.
+// format is the pixel format of data, e.g. RED or RGBA, and the pixel type is derived from the type of data.
+func (t Texture) SubImage2D(targ TextureTarget, level int, x int, y int, w int, h int, format int, data interface{}) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.SubImage2D", targ, level, x, y, w, h, format, data))
+	}
+	b := bindTarget(targ)
+	t.Bind(b)
+	p, typ, _, _ := toCtype(data)
+	C.glPixelStorei(UNPACK_ALIGNMENT, 1)
+	C.glTexSubImage2D(C.GLenum(targ), C.GLint(level), C.GLint(x), C.GLint(y), C.GLsizei(w), C.GLsizei(h), C.GLenum(format), typ, p)
+	t.Unbind(b)
+}
+
+// SubImage3D calls glTexSubImage3D to replace a w by h by d box at x, y, z of the given mipmap level of a 3D texture or 2D texture array (where z and d select the layers).
+// The other arguments are as for SubImage2D.
+func (t Texture) SubImage3D(targ TextureTarget, level int, x int, y int, z int, w int, h int, d int, format int, data interface{}) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Texture.SubImage3D", targ, level, x, y, z, w, h, d, format, data))
+	}
+	t.Bind(targ)
+	p, typ, _, _ := toCtype(data)
+	C.glPixelStorei(UNPACK_ALIGNMENT, 1)
+	C.glTexSubImage3D(C.GLenum(targ), C.GLint(level), C.GLint(x), C.GLint(y), C.GLint(z), C.GLsizei(w), C.GLsizei(h), C.GLsizei(d), C.GLenum(format), typ, p)
+	t.Unbind(targ)
+}
+
+// SubImage replaces the part of mipmap level 0 of a texture at x, y with img. x and y give the position of the bottom left corner of img.
+// targ is TEXTURE_2D or one of the cube map faces, in which case x and y give the top left corner, since the image is not flipped, like for NewTextureCube.
+func (t Texture) SubImage(targ TextureTarget, x int, y int, img image.Image) {
+	r := img.Bounds()
+	if _, ok := img.(*image.Gray); ok {
+		// convert to RGBA, since the texture is not necessarily single channel
+		m := image.NewRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+		draw.Draw(m, m.Bounds(), img, r.Min, draw.Src)
+		img = m
+	}
+	data, _, format := imageData(img, targ == TEXTURE_2D)
+	t.SubImage2D(targ, 0, x, y, r.Dx(), r.Dy(), format, data)
+}
@@ -0,0 +1,136 @@
+package gl
+
+import "bytes"
+import "errors"
+import "log"
+import "runtime"
+import "strconv"
+import "sync"
+
+// ErrWrongThread is reported in debug mode when a wrapper is called from a goroutine other than the one that called Init.
+var ErrWrongThread = errors.New("called from a goroutine other than the one that called Init")
+
+// owner is the goroutine that called Init.
+var owner int64
+
+// goid returns the id of the calling goroutine.
+func goid() int64 {
+	var b [64]byte
+	s := bytes.TrimPrefix(b[:runtime.Stack(b[:], false)], []byte("goroutine "))
+	if i := bytes.IndexByte(s, ' '); i >= 0 {
+		s = s[:i]
+	}
+	id, _ := strconv.ParseInt(string(s), 10, 64)
+	return id
+}
+
+// checkThread reports whether the wrapper call c is made by the goroutine that called Init, and reports calls from other goroutines in debug mode.
+// Before Init there is no owner and nothing to check.
+func checkThread(c debugCall) bool {
+	if owner == 0 {
+		return false
+	}
+	if goid() == owner {
+		return true
+	}
+	e := &CallError{c.fn, c.args, ErrWrongThread}
+	switch debugMode {
+	case DebugLog:
+		log.Print(e)
+	case DebugPanic:
+		panic(e)
+	}
+	return false
+}
+
+// The type RenderThread owns a goroutine locked to an OS thread, on which all GL calls are made. Other goroutines submit their GL work to it as closures.
+// The closures are executed in the order they were submitted; closures queued while the thread is busy are picked up together as a batch.
+type RenderThread struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  []func()
+	closed bool
+	id     int64
+	done   chan struct{}
+}
+
+// NewRenderThread starts a render thread and runs init on it, which should make a context current and call Init.
+// If init returns an error, the thread exits and the error is returned.
+func NewRenderThread(init func() error) (*RenderThread, error) {
+	t := &RenderThread{done: make(chan struct{})}
+	t.cond = sync.NewCond(&t.mu)
+	errc := make(chan error)
+	go t.run(init, errc)
+	if err := <-errc; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *RenderThread) run(init func() error, errc chan error) {
+	// the goroutine never unlocks, so the thread exits together with it and cannot be reused with a context still current
+	runtime.LockOSThread()
+	defer close(t.done)
+	t.id = goid()
+	if err := init(); err != nil {
+		errc <- err
+		return
+	}
+	errc <- nil
+	for {
+		t.mu.Lock()
+		for len(t.queue) == 0 && !t.closed {
+			t.cond.Wait()
+		}
+		batch := t.queue
+		t.queue = nil
+		closed := t.closed
+		t.mu.Unlock()
+		if len(batch) == 0 && closed {
+			return
+		}
+		for _, f := range batch {
+			f()
+		}
+	}
+}
+
+// DoAsync queues f for execution on the render thread and returns immediately.
+func (t *RenderThread) DoAsync(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		panic("gl: RenderThread is closed")
+	}
+	t.queue = append(t.queue, f)
+	t.cond.Signal()
+}
+
+// Do executes f on the render thread and waits for it to finish, after all previously queued closures. If f panics, Do panics with the same value.
+// Called from the render thread itself, Do executes f directly.
+func (t *RenderThread) Do(f func()) {
+	if goid() == t.id {
+		f()
+		return
+	}
+	done := make(chan interface{}, 1)
+	t.DoAsync(func() {
+		defer func() {
+			done <- recover()
+		}()
+		f()
+	})
+	if p := <-done; p != nil {
+		panic(p)
+	}
+}
+
+// Close executes the remaining queued closures and stops the render thread, which exits its OS thread. Any teardown, e.g. destroying the context, should be queued before.
+// Close must not be called from the render thread.
+func (t *RenderThread) Close() {
+	t.mu.Lock()
+	t.closed = true
+	t.cond.Signal()
+	t.mu.Unlock()
+	<-t.done
+}
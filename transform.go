@@ -0,0 +1,72 @@
+package gl
+
+import "math"
+
+// The type TRS represents an affine transformation as a scaling, followed by a rotation and a translation, i.e. Translate * Rotation.ToMat4() * Scale.
+// Unlike matrices, TRS values can be interpolated meaningfully, e.g. to blend between animation poses.
+type TRS struct {
+	Translation Vec3
+	Rotation    Quat // a unit quaternion
+	Scale       Vec3
+}
+
+// Compose returns the matrix of the transformation x.
+func (x TRS) Compose() Mat4 {
+	t, s := x.Translation, x.Scale
+	return Mul4(Translate(t[0], t[1], t[2]), x.Rotation.ToMat4(), Scale(s[0], s[1], s[2]))
+}
+
+// Decompose splits the affine transformation m into translation, rotation and scale, so that Compose returns m again.
+// A reflection is represented by a negative x scale. Shearing cannot be represented and is discarded by orthogonalizing the rotation.
+// Decompose returns false if m is not affine, i.e. its last row is not 0, 0, 0, 1, or if it is singular.
+func (m Mat4) Decompose() (TRS, bool) {
+	if m[3] != [4]float64{0, 0, 0, 1} {
+		return TRS{}, false
+	}
+	var x TRS
+	x.Translation = Vec3{m[0][3], m[1][3], m[2][3]}
+	a := m.Mat3()
+	var col [3]Vec3
+	for j := 0; j < 3; j++ {
+		col[j] = Vec3{a[0][j], a[1][j], a[2][j]}
+		x.Scale[j] = col[j].Len()
+	}
+	scale := math.Max(x.Scale[0], math.Max(x.Scale[1], x.Scale[2]))
+	if det := a.Determinant(); scale == 0 || math.Abs(det) <= singularEps*scale*scale*scale {
+		return TRS{}, false
+	} else if det < 0 {
+		x.Scale[0] = -x.Scale[0]
+		col[0] = col[0].Neg()
+	}
+	// Gram-Schmidt, so that the rotation stays orthonormal if m shears
+	col[0] = col[0].Normalize()
+	col[1] = col[1].Sub(col[0].Mul(col[0].Dot(col[1]))).Normalize()
+	col[2] = col[0].Cross(col[1])
+	var r Mat4
+	for i := 0; i < 3; i++ {
+		r[i][0], r[i][1], r[i][2] = col[0][i], col[1][i], col[2][i]
+	}
+	r[3][3] = 1
+	x.Rotation = QuatFromMat4(r)
+	return x, true
+}
+
+// Interpolate interpolates between x at t = 0 and y at t = 1, linearly for the translation and scale and spherically for the rotation.
+func (x TRS) Interpolate(y TRS, t float64) TRS {
+	return TRS{
+		Translation: x.Translation.Lerp(y.Translation, t),
+		Rotation:    x.Rotation.Slerp(y.Rotation, t),
+		Scale:       x.Scale.Lerp(y.Scale, t),
+	}
+}
+
+// InterpolateMat4 interpolates between the affine transformations a at t = 0 and b at t = 1 by decomposing them, see TRS.Interpolate.
+// It returns false if either cannot be decomposed. When interpolating repeatedly between the same poses, decompose them once instead.
+func InterpolateMat4(a, b Mat4, t float64) (Mat4, bool) {
+	x, ok := a.Decompose()
+	y, ok2 := b.Decompose()
+	if !ok || !ok2 {
+		return Mat4{}, false
+	}
+	return x.Interpolate(y, t).Compose(), true
+}
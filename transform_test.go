@@ -0,0 +1,87 @@
+package gl
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDecompose(t *testing.T) {
+	for _, c := range []struct {
+		name  string
+		m     Mat4
+		scale Vec3
+	}{
+		{"Identity", Identity, Vec3{1, 1, 1}},
+		{"Translate", Translate(1, -2, 3), Vec3{1, 1, 1}},
+		{"Rotate", RotZ(30), Vec3{1, 1, 1}},
+		{"Scale", Scale(2, 3, 4), Vec3{2, 3, 4}},
+		{"Reflect", Scale(1, -2, 3), Vec3{-1, 2, 3}},
+		{"TRS", Mul4(Translate(4, 5, 6), QuatAxisAngle(Vec3{1, 2, 3}, 123).ToMat4(), Scale(.5, 2, 7)), Vec3{.5, 2, 7}},
+		{"Rotate180", Mul4(QuatAxisAngle(Vec3{0, 1, 1}, 180).ToMat4(), Scale(3, 3, 3)), Vec3{3, 3, 3}},
+	} {
+		x, ok := c.m.Decompose()
+		if !ok {
+			t.Errorf("%s: Decompose(%v) failed", c.name, c.m)
+			continue
+		}
+		if d := maxDiff(x.Compose(), c.m); d > 1e-12 {
+			t.Errorf("%s: Compose(Decompose(m)) differs from m by %g", c.name, d)
+		}
+		for i := range x.Scale {
+			if math.Abs(x.Scale[i]-c.scale[i]) > 1e-12 {
+				t.Errorf("%s: scale %v, want %v", c.name, x.Scale, c.scale)
+				break
+			}
+		}
+		if l := x.Rotation.Len(); math.Abs(l-1) > 1e-12 {
+			t.Errorf("%s: rotation %v has length %g", c.name, x.Rotation, l)
+		}
+	}
+}
+
+func TestDecomposeFails(t *testing.T) {
+	for _, m := range []Mat4{
+		{},
+		Scale(1, 0, 1),
+		Perspective(60, 1, 1, 10),
+	} {
+		if x, ok := m.Decompose(); ok {
+			t.Errorf("Decompose(%v) = %v; want failure", m, x)
+		}
+	}
+}
+
+func TestSlerp(t *testing.T) {
+	z, x := Vec3{0, 0, 1}, Vec3{1, 0, 0}
+	for _, c := range []struct {
+		name string
+		q, r Quat
+		mid  Quat
+	}{
+		{"Quarter", QuatIdentity, QuatAxisAngle(z, 90), QuatAxisAngle(z, 45)},
+		{"Half", QuatIdentity, QuatAxisAngle(x, 180), QuatAxisAngle(x, 90)},
+		// 350 and 10 degrees meet at 0 along the shorter arc, not at 180
+		{"ShorterArc", QuatAxisAngle(z, 350), QuatAxisAngle(z, 10), QuatIdentity},
+		{"Opposite", QuatAxisAngle(x, 30), QuatAxisAngle(x, 90).Mul(Quat{0, 0, 0, -1}), QuatAxisAngle(x, 60)},
+		// nearly parallel, where Slerp falls back to Nlerp
+		{"Close", QuatAxisAngle(z, 20), QuatAxisAngle(z, 20.01), QuatAxisAngle(z, 20.005)},
+	} {
+		for _, e := range []struct {
+			t    float64
+			want Quat
+		}{
+			{0, c.q},
+			{0.5, c.mid},
+			{1, c.r},
+		} {
+			// q and -q are the same rotation
+			got := c.q.Slerp(c.r, e.t)
+			if d := maxDiff(got.ToMat4(), e.want.ToMat4()); d > 1e-12 {
+				t.Errorf("%s: Slerp(%v, %v, %g) = %v, want %v", c.name, c.q, c.r, e.t, got, e.want)
+			}
+			if l := got.Len(); math.Abs(l-1) > 1e-12 {
+				t.Errorf("%s: Slerp(%v, %v, %g) has length %g", c.name, c.q, c.r, e.t, l)
+			}
+		}
+	}
+}
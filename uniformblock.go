@@ -0,0 +1,68 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+
+// The type UniformBlock describes an active uniform block of a linked program.
+type UniformBlock struct {
+	Name    string
+	Index   int            // index of the block within the program
+	Size    int            // minimum size of the buffer backing the block in bytes
+	Offsets map[string]int // byte offsets of the members within the block
+}
+
+// reflectBlocks queries the active uniform blocks of the program and their members.
+// Contexts without uniform buffers, i.e. before OpenGL 3.1 and without ARB_uniform_buffer_object, reject the queries, so programs have no blocks there.
+func (p *Program) reflectBlocks() {
+	var n, l, size, count C.GLint
+	var dummys C.GLsizei
+	var dummyi C.GLint
+	var dummye C.GLenum
+	p.blocks = make(map[string]*UniformBlock)
+	if !caps.AtLeast(3, 1) && !caps.HasExtension("GL_ARB_uniform_buffer_object") {
+		return
+	}
+	C.glGetProgramiv(p.i, ACTIVE_UNIFORM_BLOCKS, &n)
+	C.glGetProgramiv(p.i, ACTIVE_UNIFORM_BLOCK_MAX_NAME_LENGTH, &l)
+	buf := make([]C.GLchar, l+1)
+	C.glGetProgramiv(p.i, ACTIVE_UNIFORM_MAX_LENGTH, &l)
+	ubuf := make([]C.GLchar, l+1)
+	for i := C.GLuint(0); i < C.GLuint(n); i++ {
+		C.glGetActiveUniformBlockName(p.i, i, C.GLsizei(len(buf)), nil, &buf[0])
+		C.glGetActiveUniformBlockiv(p.i, i, UNIFORM_BLOCK_DATA_SIZE, &size)
+		C.glGetActiveUniformBlockiv(p.i, i, UNIFORM_BLOCK_ACTIVE_UNIFORMS, &count)
+		b := &UniformBlock{Name: C.GoString((*C.char)(&buf[0])), Index: int(i), Size: int(size), Offsets: make(map[string]int)}
+		p.blocks[b.Name] = b
+		if count == 0 {
+			continue
+		}
+		idx := make([]C.GLint, count)
+		C.glGetActiveUniformBlockiv(p.i, i, UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES, &idx[0])
+		uidx := make([]C.GLuint, count)
+		for j := range idx {
+			uidx[j] = C.GLuint(idx[j])
+		}
+		off := make([]C.GLint, count)
+		C.glGetActiveUniformsiv(p.i, C.GLsizei(count), &uidx[0], UNIFORM_OFFSET, &off[0])
+		for j := range uidx {
+			C.glGetActiveUniform(p.i, uidx[j], C.GLsizei(len(ubuf)), &dummys, &dummyi, &dummye, &ubuf[0])
+			b.Offsets[C.GoString((*C.char)(&ubuf[0]))] = int(off[j])
+		}
+	}
+}
+
+// UniformBlock returns the description of the named uniform block or nil if the program has no such active block.
+func (p *Program) UniformBlock(name string) *UniformBlock {
+	return p.blocks[name]
+}
+
+// BindUniformBlock calls glUniformBlockBinding to connect the named uniform block to a uniform buffer binding point.
+// A buffer is attached to the binding point with Buffer.BindBase(UNIFORM_BUFFER, binding), so that it can be shared by all programs using the same binding.
+func (p *Program) BindUniformBlock(name string, binding int) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("Program.BindUniformBlock", name, binding))
+	}
+	if b, ok := p.blocks[name]; ok {
+		C.glUniformBlockBinding(p.i, C.GLuint(b.Index), C.GLuint(binding))
+	}
+}
@@ -0,0 +1,190 @@
+package gl
+
+import "math"
+
+// The type Vec2 represents a double precision 2-element vector.
+type Vec2 [2]float64
+
+// The type Vec3 represents a double precision 3-element vector. It can be passed to Mat4.Apply3.
+type Vec3 [3]float64
+
+// The type Vec4 represents a double precision 4-element vector. It can be passed to Mat4.Apply4.
+type Vec4 [4]float64
+
+// Add returns a+b.
+func (a Vec2) Add(b Vec2) Vec2 {
+	return Vec2{a[0] + b[0], a[1] + b[1]}
+}
+
+// Sub returns a-b.
+func (a Vec2) Sub(b Vec2) Vec2 {
+	return Vec2{a[0] - b[0], a[1] - b[1]}
+}
+
+// Mul returns a scaled by s.
+func (a Vec2) Mul(s float64) Vec2 {
+	return Vec2{a[0] * s, a[1] * s}
+}
+
+// MulElem returns the element-wise product of a and b.
+func (a Vec2) MulElem(b Vec2) Vec2 {
+	return Vec2{a[0] * b[0], a[1] * b[1]}
+}
+
+// Neg returns -a.
+func (a Vec2) Neg() Vec2 {
+	return Vec2{-a[0], -a[1]}
+}
+
+// Dot returns the dot product of a and b.
+func (a Vec2) Dot(b Vec2) float64 {
+	return a[0]*b[0] + a[1]*b[1]
+}
+
+// Len returns the length of a.
+func (a Vec2) Len() float64 {
+	return math.Sqrt(a.Dot(a))
+}
+
+// Normalize returns a scaled to unit length. The zero vector is returned unchanged.
+func (a Vec2) Normalize() Vec2 {
+	if l := a.Len(); l != 0 {
+		return a.Mul(1 / l)
+	}
+	return a
+}
+
+// Lerp interpolates linearly between a at t = 0 and b at t = 1.
+func (a Vec2) Lerp(b Vec2, t float64) Vec2 {
+	return a.Add(b.Sub(a).Mul(t))
+}
+
+// Vec3 returns the vector extended by z.
+func (a Vec2) Vec3(z float64) Vec3 {
+	return Vec3{a[0], a[1], z}
+}
+
+// Add returns a+b.
+func (a Vec3) Add(b Vec3) Vec3 {
+	return Vec3{a[0] + b[0], a[1] + b[1], a[2] + b[2]}
+}
+
+// Sub returns a-b.
+func (a Vec3) Sub(b Vec3) Vec3 {
+	return Vec3{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+// Mul returns a scaled by s.
+func (a Vec3) Mul(s float64) Vec3 {
+	return Vec3{a[0] * s, a[1] * s, a[2] * s}
+}
+
+// MulElem returns the element-wise product of a and b.
+func (a Vec3) MulElem(b Vec3) Vec3 {
+	return Vec3{a[0] * b[0], a[1] * b[1], a[2] * b[2]}
+}
+
+// Neg returns -a.
+func (a Vec3) Neg() Vec3 {
+	return Vec3{-a[0], -a[1], -a[2]}
+}
+
+// Dot returns the dot product of a and b.
+func (a Vec3) Dot(b Vec3) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+// Cross returns the cross product of a and b.
+func (a Vec3) Cross(b Vec3) Vec3 {
+	return Vec3{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+// Len returns the length of a.
+func (a Vec3) Len() float64 {
+	return math.Sqrt(a.Dot(a))
+}
+
+// Normalize returns a scaled to unit length. The zero vector is returned unchanged.
+func (a Vec3) Normalize() Vec3 {
+	if l := a.Len(); l != 0 {
+		return a.Mul(1 / l)
+	}
+	return a
+}
+
+// Lerp interpolates linearly between a at t = 0 and b at t = 1.
+func (a Vec3) Lerp(b Vec3, t float64) Vec3 {
+	return a.Add(b.Sub(a).Mul(t))
+}
+
+// Vec2 returns the first two elements of a.
+func (a Vec3) Vec2() Vec2 {
+	return Vec2{a[0], a[1]}
+}
+
+// Vec4 returns the vector extended by w, e.g. 1 for a point and 0 for a direction.
+func (a Vec3) Vec4(w float64) Vec4 {
+	return Vec4{a[0], a[1], a[2], w}
+}
+
+// Add returns a+b.
+func (a Vec4) Add(b Vec4) Vec4 {
+	return Vec4{a[0] + b[0], a[1] + b[1], a[2] + b[2], a[3] + b[3]}
+}
+
+// Sub returns a-b.
+func (a Vec4) Sub(b Vec4) Vec4 {
+	return Vec4{a[0] - b[0], a[1] - b[1], a[2] - b[2], a[3] - b[3]}
+}
+
+// Mul returns a scaled by s.
+func (a Vec4) Mul(s float64) Vec4 {
+	return Vec4{a[0] * s, a[1] * s, a[2] * s, a[3] * s}
+}
+
+// MulElem returns the element-wise product of a and b.
+func (a Vec4) MulElem(b Vec4) Vec4 {
+	return Vec4{a[0] * b[0], a[1] * b[1], a[2] * b[2], a[3] * b[3]}
+}
+
+// Neg returns -a.
+func (a Vec4) Neg() Vec4 {
+	return Vec4{-a[0], -a[1], -a[2], -a[3]}
+}
+
+// Dot returns the dot product of a and b.
+func (a Vec4) Dot(b Vec4) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2] + a[3]*b[3]
+}
+
+// Len returns the length of a.
+func (a Vec4) Len() float64 {
+	return math.Sqrt(a.Dot(a))
+}
+
+// Normalize returns a scaled to unit length. The zero vector is returned unchanged.
+func (a Vec4) Normalize() Vec4 {
+	if l := a.Len(); l != 0 {
+		return a.Mul(1 / l)
+	}
+	return a
+}
+
+// Lerp interpolates linearly between a at t = 0 and b at t = 1.
+func (a Vec4) Lerp(b Vec4, t float64) Vec4 {
+	return a.Add(b.Sub(a).Mul(t))
+}
+
+// Vec3 returns the first three elements of a.
+func (a Vec4) Vec3() Vec3 {
+	return Vec3{a[0], a[1], a[2]}
+}
+
+// Project returns the first three elements of a divided by the fourth, like Mat4.Apply3 does.
+func (a Vec4) Project() Vec3 {
+	return Vec3{a[0] / a[3], a[1] / a[3], a[2] / a[3]}
+}
@@ -0,0 +1,93 @@
+package gl
+
+// #include "glfuncs.h"
+import "C"
+
+// The type VertexArray represents a vertex array object.
+// It records the attribute layouts set up with EnableAttrib and the element buffer set with SetElements, so that a single Bind restores them before drawing.
+// A vertex array must be bound for drawing to work on a core profile context.
+type VertexArray struct {
+	i    C.GLuint
+	elem *Buffer
+}
+
+// NewVertexArray creates a new vertex array object using glGenVertexArrays.
+func NewVertexArray() *VertexArray {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("NewVertexArray"))
+	}
+	var i C.GLuint
+
+	C.glGenVertexArrays(1, &i)
+	return &VertexArray{i: i}
+}
+
+// Delete calls glDeleteVertexArrays
+func (v *VertexArray) Delete() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("VertexArray.Delete"))
+	}
+	C.glDeleteVertexArrays(1, &v.i)
+	delete(cache.buffers, ELEMENT_ARRAY_BUFFER)
+}
+
+// Bind calls glBindVertexArray
+func (v *VertexArray) Bind() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("VertexArray.Bind"))
+	}
+	C.glBindVertexArray(v.i)
+	// the element buffer binding belongs to the vertex array
+	delete(cache.buffers, ELEMENT_ARRAY_BUFFER)
+}
+
+// Unbind calls glBindVertexArray with a 0 argument
+func (*VertexArray) Unbind() {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("VertexArray.Unbind"))
+	}
+	C.glBindVertexArray(0)
+	delete(cache.buffers, ELEMENT_ARRAY_BUFFER)
+}
+
+// EnableAttrib binds the vertex array and calls Program.EnableAttrib with the remaining arguments, recording the attribute in the vertex array.
+func (v *VertexArray) EnableAttrib(p *Program, loc string, buf *Buffer, offset int, size int, stride int, norm bool) {
+	v.Bind()
+	p.EnableAttrib(loc, buf, offset, size, stride, norm)
+	v.Unbind()
+}
+
+// DisableAttrib binds the vertex array and calls Program.DisableAttrib.
+func (v *VertexArray) DisableAttrib(p *Program, loc string) {
+	v.Bind()
+	p.DisableAttrib(loc)
+	v.Unbind()
+}
+
+// AttribDivisor binds the vertex array and calls Program.AttribDivisor.
+func (v *VertexArray) AttribDivisor(p *Program, loc string, divisor int) {
+	v.Bind()
+	p.AttribDivisor(loc, divisor)
+	v.Unbind()
+}
+
+// SetElements binds buf as the ELEMENT_ARRAY_BUFFER of the vertex array. buf may be nil to remove the element buffer.
+// NB: The element buffer binding is part of the vertex array state; calling Buffer.Set or Buffer.Unbind with ELEMENT_ARRAY_BUFFER while the vertex array is bound will change it.
+func (v *VertexArray) SetElements(buf *Buffer) {
+	if debugMode != DebugOff {
+		defer checkError(debugEnter("VertexArray.SetElements", buf))
+	}
+	v.Bind()
+	if buf != nil {
+		buf.Bind(ELEMENT_ARRAY_BUFFER)
+	} else {
+		bindBuffer(ELEMENT_ARRAY_BUFFER, 0)
+	}
+	v.Unbind()
+	v.elem = buf
+}
+
+// Elements returns the element buffer set with SetElements.
+func (v *VertexArray) Elements() *Buffer {
+	return v.elem
+}